@@ -0,0 +1,152 @@
+// Package bitsets provides sets of non-negative integers stored as bits: Bitset for
+// dense ids and RoaringBitset, a compressed variant for sparse ones.
+package bitsets
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+type Bitset struct {
+	words []uint64
+}
+
+func NewBitset(v []int) *Bitset {
+	co := &Bitset{}
+	for _, i := range v {
+		co.Set(i)
+	}
+	return co
+}
+
+func FromSliceCollection(co *slices.SliceCollection[int]) *Bitset {
+	return NewBitset(co.All())
+}
+
+func (co *Bitset) Set(i int) *Bitset {
+	w := index(i)
+	if w >= len(co.words) {
+		words := make([]uint64, w+1, 2*(w+1))
+		copy(words, co.words)
+		co.words = words
+	}
+	co.words[w] |= 1 << (uint(i) % 64)
+	return co
+}
+
+func (co *Bitset) Clear(i int) *Bitset {
+	if w := index(i); w < len(co.words) {
+		co.words[w] &^= 1 << (uint(i) % 64)
+	}
+	return co
+}
+
+func (co *Bitset) Test(i int) bool {
+	w := index(i)
+	return w < len(co.words) && co.words[w]&(1<<(uint(i)%64)) != 0
+}
+
+// Count returns the number of set bits.
+func (co *Bitset) Count() (n int) {
+	for _, w := range co.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+func (co *Bitset) Empty() bool {
+	for _, w := range co.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (co *Bitset) Clone() *Bitset {
+	return &Bitset{words: append([]uint64(nil), co.words...)}
+}
+
+func (co *Bitset) Equal(other *Bitset) bool {
+	long, short := co.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+	for i, w := range long {
+		if i < len(short) && w != short[i] || i >= len(short) && w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (co *Bitset) And(other *Bitset) *Bitset {
+	return co.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+func (co *Bitset) Or(other *Bitset) *Bitset {
+	return co.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+func (co *Bitset) Xor(other *Bitset) *Bitset {
+	return co.combine(other, func(a, b uint64) uint64 { return a ^ b })
+}
+
+func (co *Bitset) AndNot(other *Bitset) *Bitset {
+	return co.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Each visits the set bits in ascending order until fn returns false.
+func (co *Bitset) Each(fn func(int) bool) *Bitset {
+	for w, word := range co.words {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			if !fn(w*64 + bit) {
+				return co
+			}
+			word &= word - 1
+		}
+	}
+	return co
+}
+
+func (co *Bitset) All() []int {
+	ret := make([]int, 0, co.Count())
+	co.Each(func(i int) bool {
+		ret = append(ret, i)
+		return true
+	})
+	return ret
+}
+
+func (co *Bitset) ToSliceCollection() *slices.SliceCollection[int] {
+	return slices.NewSliceCollection(co.All())
+}
+
+func (co *Bitset) combine(other *Bitset, op func(a, b uint64) uint64) *Bitset {
+	n := len(co.words)
+	if len(other.words) > n {
+		n = len(other.words)
+	}
+	ret := &Bitset{words: make([]uint64, n)}
+	for i := range ret.words {
+		var a, b uint64
+		if i < len(co.words) {
+			a = co.words[i]
+		}
+		if i < len(other.words) {
+			b = other.words[i]
+		}
+		ret.words[i] = op(a, b)
+	}
+	return ret
+}
+
+func index(i int) int {
+	if i < 0 {
+		panic(fmt.Sprintf("bitsets: negative index %d", i))
+	}
+	return i / 64
+}
@@ -0,0 +1,57 @@
+package bitsets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestNewBitset(t *testing.T) {
+	expected := []int{1, 64, 200}
+	actual := NewBitset([]int{200, 1, 64, 1})
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, expected, FromSliceCollection(slices.NewSliceCollection(expected)).All())
+	assert.Panics(t, func() { NewBitset([]int{-1}) })
+}
+
+func TestBitset_SetClearTest(t *testing.T) {
+	data := NewBitset(nil).Set(3).Set(130)
+	assert.Equal(t, true, data.Test(3))
+	assert.Equal(t, true, data.Test(130))
+	assert.Equal(t, false, data.Test(4))
+	assert.Equal(t, false, data.Test(10000))
+
+	data.Clear(3).Clear(10000)
+	assert.Equal(t, false, data.Test(3))
+	assert.Equal(t, 1, data.Count())
+	assert.Equal(t, true, data.Clear(130).Empty())
+}
+
+func TestBitset_Operations(t *testing.T) {
+	a := NewBitset([]int{1, 2, 3, 100})
+	b := NewBitset([]int{2, 3, 4})
+	assert.Equal(t, []int{2, 3}, a.And(b).All())
+	assert.Equal(t, []int{1, 2, 3, 4, 100}, a.Or(b).All())
+	assert.Equal(t, []int{1, 4, 100}, a.Xor(b).All())
+	assert.Equal(t, []int{1, 100}, a.AndNot(b).All())
+	assert.Equal(t, []int{4}, b.AndNot(a).All())
+}
+
+func TestBitset_Equal(t *testing.T) {
+	a := NewBitset([]int{1, 200}).Clear(200)
+	assert.Equal(t, true, a.Equal(NewBitset([]int{1})))
+	assert.Equal(t, false, a.Equal(NewBitset([]int{2})))
+	clone := a.Clone().Set(5)
+	assert.Equal(t, false, a.Equal(clone))
+}
+
+func TestBitset_EachAndToSliceCollection(t *testing.T) {
+	var actual []int
+	NewBitset([]int{5, 70, 300}).Each(func(i int) bool {
+		actual = append(actual, i)
+		return i < 70
+	})
+	assert.Equal(t, []int{5, 70}, actual)
+	assert.Equal(t, []int{5, 70}, NewBitset([]int{70, 5}).ToSliceCollection().All())
+}
@@ -0,0 +1,399 @@
+package bitsets
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// arrayLimit is the cardinality above which a container switches from a sorted array to a bitmap.
+const arrayLimit = 4096
+
+// RoaringBitset splits values in [0, 2^32) by their high 16 bits into containers that hold
+// the low 16 bits either as a sorted array, when sparse, or as a 65536 bit bitmap.
+type RoaringBitset struct {
+	keys       []uint16
+	containers []*container
+}
+
+type container struct {
+	// array is used while bitmap is nil.
+	array  []uint16
+	bitmap []uint64
+	card   int
+}
+
+func NewRoaringBitset(v []int) *RoaringBitset {
+	co := &RoaringBitset{}
+	for _, i := range v {
+		co.Set(i)
+	}
+	return co
+}
+
+func RoaringFromSliceCollection(co *slices.SliceCollection[int]) *RoaringBitset {
+	return NewRoaringBitset(co.All())
+}
+
+func (co *RoaringBitset) Set(i int) *RoaringBitset {
+	high, low := split(i)
+	pos, ok := co.find(high)
+	if !ok {
+		co.keys = append(co.keys, 0)
+		co.containers = append(co.containers, nil)
+		copy(co.keys[pos+1:], co.keys[pos:])
+		copy(co.containers[pos+1:], co.containers[pos:])
+		co.keys[pos], co.containers[pos] = high, &container{}
+	}
+	co.containers[pos].add(low)
+	return co
+}
+
+func (co *RoaringBitset) Clear(i int) *RoaringBitset {
+	high, low := split(i)
+	if pos, ok := co.find(high); ok {
+		c := co.containers[pos]
+		c.remove(low)
+		if c.card == 0 {
+			co.keys = append(co.keys[:pos], co.keys[pos+1:]...)
+			co.containers = append(co.containers[:pos], co.containers[pos+1:]...)
+		}
+	}
+	return co
+}
+
+func (co *RoaringBitset) Test(i int) bool {
+	high, low := split(i)
+	pos, ok := co.find(high)
+	return ok && co.containers[pos].contains(low)
+}
+
+func (co *RoaringBitset) Count() (n int) {
+	for _, c := range co.containers {
+		n += c.card
+	}
+	return n
+}
+
+func (co *RoaringBitset) Empty() bool {
+	return len(co.keys) == 0
+}
+
+func (co *RoaringBitset) Clone() *RoaringBitset {
+	ret := &RoaringBitset{keys: append([]uint16(nil), co.keys...), containers: make([]*container, len(co.containers))}
+	for i, c := range co.containers {
+		ret.containers[i] = c.clone()
+	}
+	return ret
+}
+
+func (co *RoaringBitset) Equal(other *RoaringBitset) bool {
+	if len(co.keys) != len(other.keys) {
+		return false
+	}
+	for i, k := range co.keys {
+		if other.keys[i] != k || co.containers[i].card != other.containers[i].card {
+			return false
+		}
+		// the representation only depends on the cardinality, so equal cards share it.
+		a, b := co.containers[i], other.containers[i]
+		for j := range a.array {
+			if a.array[j] != b.array[j] {
+				return false
+			}
+		}
+		for j := range a.bitmap {
+			if a.bitmap[j] != b.bitmap[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (co *RoaringBitset) And(other *RoaringBitset) *RoaringBitset {
+	return co.combine(other, opAnd)
+}
+
+func (co *RoaringBitset) Or(other *RoaringBitset) *RoaringBitset {
+	return co.combine(other, opOr)
+}
+
+func (co *RoaringBitset) Xor(other *RoaringBitset) *RoaringBitset {
+	return co.combine(other, opXor)
+}
+
+func (co *RoaringBitset) AndNot(other *RoaringBitset) *RoaringBitset {
+	return co.combine(other, opAndNot)
+}
+
+// Each visits the set values in ascending order until fn returns false.
+func (co *RoaringBitset) Each(fn func(int) bool) *RoaringBitset {
+	for i, c := range co.containers {
+		base := int(co.keys[i]) << 16
+		if c.bitmap == nil {
+			for _, low := range c.array {
+				if !fn(base + int(low)) {
+					return co
+				}
+			}
+			continue
+		}
+		for w, word := range c.bitmap {
+			for word != 0 {
+				if !fn(base + w*64 + bits.TrailingZeros64(word)) {
+					return co
+				}
+				word &= word - 1
+			}
+		}
+	}
+	return co
+}
+
+func (co *RoaringBitset) All() []int {
+	ret := make([]int, 0, co.Count())
+	co.Each(func(i int) bool {
+		ret = append(ret, i)
+		return true
+	})
+	return ret
+}
+
+func (co *RoaringBitset) ToSliceCollection() *slices.SliceCollection[int] {
+	return slices.NewSliceCollection(co.All())
+}
+
+func (co *RoaringBitset) ToBitset() *Bitset {
+	ret := &Bitset{}
+	co.Each(func(i int) bool {
+		ret.Set(i)
+		return true
+	})
+	return ret
+}
+
+type setOp int
+
+const (
+	opAnd setOp = iota
+	opOr
+	opXor
+	opAndNot
+)
+
+// keeps reports whether a value belongs to the result given its membership in both operands.
+func (op setOp) keeps(inLeft, inRight bool) bool {
+	switch op {
+	case opAnd:
+		return inLeft && inRight
+	case opOr:
+		return inLeft || inRight
+	case opXor:
+		return inLeft != inRight
+	}
+	return inLeft && !inRight
+}
+
+func (op setOp) word(a, b uint64) uint64 {
+	switch op {
+	case opAnd:
+		return a & b
+	case opOr:
+		return a | b
+	case opXor:
+		return a ^ b
+	}
+	return a &^ b
+}
+
+// combine applies op container by container, containers present on one side only are
+// copied as they are when op keeps them.
+func (co *RoaringBitset) combine(other *RoaringBitset, op setOp) *RoaringBitset {
+	ret := &RoaringBitset{}
+	keepLeft, keepRight := op.keeps(true, false), op.keeps(false, true)
+	i, j := 0, 0
+	for i < len(co.keys) || j < len(other.keys) {
+		var key uint16
+		var c *container
+		switch {
+		case j >= len(other.keys) || i < len(co.keys) && co.keys[i] < other.keys[j]:
+			key = co.keys[i]
+			if keepLeft {
+				c = co.containers[i].clone()
+			}
+			i++
+		case i >= len(co.keys) || other.keys[j] < co.keys[i]:
+			key = other.keys[j]
+			if keepRight {
+				c = other.containers[j].clone()
+			}
+			j++
+		default:
+			key, c = co.keys[i], combineContainers(co.containers[i], other.containers[j], op)
+			i++
+			j++
+		}
+		if c != nil && c.card > 0 {
+			ret.keys = append(ret.keys, key)
+			ret.containers = append(ret.containers, c)
+		}
+	}
+	return ret
+}
+
+// combineContainers merges two arrays directly and filters an array when the result is a
+// subset of it, only containers involving a bitmap are combined word by word.
+func combineContainers(a, b *container, op setOp) *container {
+	switch {
+	case a.bitmap == nil && b.bitmap == nil:
+		return mergeArrays(a.array, b.array, op)
+	case a.bitmap == nil && !op.keeps(false, true):
+		return filterArray(a.array, func(low uint16) bool { return op.keeps(true, b.contains(low)) })
+	case b.bitmap == nil && !op.keeps(true, false):
+		return filterArray(b.array, func(low uint16) bool { return op.keeps(a.contains(low), true) })
+	}
+
+	x, y := a.words(), b.words()
+	c := &container{bitmap: make([]uint64, len(x))}
+	for w := range c.bitmap {
+		c.bitmap[w] = op.word(x[w], y[w])
+		c.card += bits.OnesCount64(c.bitmap[w])
+	}
+	c.optimize()
+	return c
+}
+
+func mergeArrays(a, b []uint16, op setOp) *container {
+	c := &container{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var low uint16
+		var inLeft, inRight bool
+		switch {
+		case j >= len(b) || i < len(a) && a[i] < b[j]:
+			low, inLeft = a[i], true
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			low, inRight = b[j], true
+			j++
+		default:
+			low, inLeft, inRight = a[i], true, true
+			i++
+			j++
+		}
+		if op.keeps(inLeft, inRight) {
+			c.array = append(c.array, low)
+		}
+	}
+	c.card = len(c.array)
+	if c.card > arrayLimit {
+		c.bitmap, c.array = c.words(), nil
+	}
+	return c
+}
+
+func filterArray(array []uint16, fn func(uint16) bool) *container {
+	c := &container{}
+	for _, low := range array {
+		if fn(low) {
+			c.array = append(c.array, low)
+		}
+	}
+	c.card = len(c.array)
+	return c
+}
+
+func (co *RoaringBitset) find(high uint16) (int, bool) {
+	pos := sort.Search(len(co.keys), func(i int) bool { return co.keys[i] >= high })
+	return pos, pos < len(co.keys) && co.keys[pos] == high
+}
+
+func (c *container) add(low uint16) {
+	if c.bitmap != nil {
+		if c.bitmap[low/64]&(1<<(low%64)) == 0 {
+			c.bitmap[low/64] |= 1 << (low % 64)
+			c.card++
+		}
+		return
+	}
+	pos := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	if pos < len(c.array) && c.array[pos] == low {
+		return
+	}
+	c.array = append(c.array, 0)
+	copy(c.array[pos+1:], c.array[pos:])
+	c.array[pos] = low
+	c.card++
+	if c.card > arrayLimit {
+		c.bitmap, c.array = c.words(), nil
+	}
+}
+
+func (c *container) remove(low uint16) {
+	if c.bitmap != nil {
+		if c.bitmap[low/64]&(1<<(low%64)) != 0 {
+			c.bitmap[low/64] &^= 1 << (low % 64)
+			c.card--
+			c.optimize()
+		}
+		return
+	}
+	pos := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	if pos < len(c.array) && c.array[pos] == low {
+		c.array = append(c.array[:pos], c.array[pos+1:]...)
+		c.card--
+	}
+}
+
+func (c *container) contains(low uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[low/64]&(1<<(low%64)) != 0
+	}
+	pos := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	return pos < len(c.array) && c.array[pos] == low
+}
+
+func (c *container) clone() *container {
+	return &container{
+		array:  append([]uint16(nil), c.array...),
+		bitmap: append([]uint64(nil), c.bitmap...),
+		card:   c.card,
+	}
+}
+
+// words returns the container as a bitmap, sharing it when the container already is one.
+func (c *container) words() []uint64 {
+	if c.bitmap != nil {
+		return c.bitmap
+	}
+	ret := make([]uint64, 1024)
+	for _, low := range c.array {
+		ret[low/64] |= 1 << (low % 64)
+	}
+	return ret
+}
+
+// optimize turns a bitmap container back into an array once it is sparse enough.
+func (c *container) optimize() {
+	if c.bitmap == nil || c.card > arrayLimit {
+		return
+	}
+	c.array = make([]uint16, 0, c.card)
+	for w, word := range c.bitmap {
+		for word != 0 {
+			c.array = append(c.array, uint16(w*64+bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+	c.bitmap = nil
+}
+
+func split(i int) (uint16, uint16) {
+	if i < 0 || uint64(i) > 1<<32-1 {
+		panic(fmt.Sprintf("bitsets: value %d out of range [0, 2^32)", i))
+	}
+	return uint16(uint32(i) >> 16), uint16(i)
+}
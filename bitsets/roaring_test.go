@@ -0,0 +1,114 @@
+package bitsets
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestNewRoaringBitset(t *testing.T) {
+	expected := []int{1, 70000, 1 << 31}
+	actual := NewRoaringBitset([]int{1 << 31, 1, 70000, 1})
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, expected, RoaringFromSliceCollection(slices.NewSliceCollection(expected)).All())
+	assert.Panics(t, func() { NewRoaringBitset([]int{-1}) })
+	assert.Panics(t, func() { NewRoaringBitset([]int{1 << 32}) })
+}
+
+func TestRoaringBitset_SetClearTest(t *testing.T) {
+	data := NewRoaringBitset(nil)
+	for i := 0; i < 10000; i += 2 {
+		data.Set(i)
+	}
+	assert.Equal(t, 5000, data.Count())
+	assert.NotNil(t, data.containers[0].bitmap)
+	assert.Equal(t, true, data.Test(9998))
+	assert.Equal(t, false, data.Test(9999))
+
+	for i := 0; i < 10000; i += 4 {
+		data.Clear(i)
+	}
+	assert.Equal(t, 2500, data.Count())
+	assert.Nil(t, data.containers[0].bitmap)
+	assert.Equal(t, false, data.Test(0))
+	assert.Equal(t, true, data.Test(2))
+
+	for i := 2; i < 10000; i += 4 {
+		data.Clear(i)
+	}
+	assert.Equal(t, true, data.Empty())
+}
+
+func TestRoaringBitset_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	var av, bv []int
+	for i := 0; i < 20000; i++ {
+		av = append(av, r.Intn(300000))
+		bv = append(bv, r.Intn(300000))
+	}
+	a, b := NewRoaringBitset(av), NewRoaringBitset(bv)
+	da, db := NewBitset(av), NewBitset(bv)
+
+	assert.Equal(t, da.And(db).All(), a.And(b).All())
+	assert.Equal(t, da.Or(db).All(), a.Or(b).All())
+	assert.Equal(t, da.Xor(db).All(), a.Xor(b).All())
+	assert.Equal(t, da.AndNot(db).All(), a.AndNot(b).All())
+	assert.Equal(t, true, a.ToBitset().Equal(da))
+}
+
+func TestRoaringBitset_OperationsMixedContainers(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	// containers range from a handful of values to dense bitmaps on either side.
+	var av, bv []int
+	for key, density := range []int{2, 100, 3000, 4000, 8000, 40000} {
+		for i := 0; i < density; i++ {
+			av = append(av, key<<16+r.Intn(1<<16))
+		}
+		for i := 0; i < 6000-density; i++ {
+			bv = append(bv, key<<16+r.Intn(1<<16))
+		}
+	}
+	a, b := NewRoaringBitset(av), NewRoaringBitset(bv)
+	da, db := NewBitset(av), NewBitset(bv)
+
+	for _, pair := range [][2]*RoaringBitset{{a, b}, {b, a}} {
+		x, y := pair[0].ToBitset(), pair[1].ToBitset()
+		assert.Equal(t, x.And(y).All(), pair[0].And(pair[1]).All())
+		assert.Equal(t, x.Or(y).All(), pair[0].Or(pair[1]).All())
+		assert.Equal(t, x.Xor(y).All(), pair[0].Xor(pair[1]).All())
+		assert.Equal(t, x.AndNot(y).All(), pair[0].AndNot(pair[1]).All())
+	}
+	assert.Equal(t, true, a.Or(b).Equal(NewRoaringBitset(da.Or(db).All())))
+}
+
+func TestRoaringBitset_SparseOperationsStayArrays(t *testing.T) {
+	a, b := NewRoaringBitset([]int{1, 5}), NewRoaringBitset([]int{5, 9})
+	for _, actual := range []*RoaringBitset{a.And(b), a.Or(b), a.Xor(b), a.AndNot(b)} {
+		for _, c := range actual.containers {
+			assert.Nil(t, c.bitmap)
+		}
+	}
+	allocs := testing.AllocsPerRun(10, func() { a.And(b) })
+	assert.Less(t, allocs, float64(10))
+}
+
+func TestRoaringBitset_Equal(t *testing.T) {
+	a := NewRoaringBitset([]int{1, 70000})
+	clone := a.Clone()
+	assert.Equal(t, true, a.Equal(clone))
+	clone.Set(2)
+	assert.Equal(t, false, a.Equal(clone))
+	assert.Equal(t, []int{1, 70000}, a.All())
+}
+
+func TestRoaringBitset_EachAndToSliceCollection(t *testing.T) {
+	var actual []int
+	NewRoaringBitset([]int{5, 70000, 300000}).Each(func(i int) bool {
+		actual = append(actual, i)
+		return i < 70000
+	})
+	assert.Equal(t, []int{5, 70000}, actual)
+	assert.Equal(t, []int{5, 70000}, NewRoaringBitset([]int{70000, 5}).ToSliceCollection().All())
+}
@@ -0,0 +1,202 @@
+// Package graphs provides a generic Graph with weighted directed or undirected edges,
+// traversals, topological sorting, shortest paths and connected components.
+package graphs
+
+import (
+	"github.com/wwaayyaa/go-collection/maps"
+)
+
+type Edge[K comparable] struct {
+	From   K
+	To     K
+	Weight float64
+}
+
+// Graph stores nodes keyed by K with values of type V. Nodes and edges are iterated
+// in insertion order, so traversals are deterministic.
+type Graph[K comparable, V any] struct {
+	directed  bool
+	nodes     map[K]V
+	order     []K
+	adjacency map[K][]K
+	weights   map[K]map[K]float64
+}
+
+func NewGraph[K comparable, V any](directed bool) *Graph[K, V] {
+	return &Graph[K, V]{
+		directed:  directed,
+		nodes:     map[K]V{},
+		adjacency: map[K][]K{},
+		weights:   map[K]map[K]float64{},
+	}
+}
+
+// FromAdjacency builds a graph from an adjacency list, every listed neighbor becomes a node.
+// Node values are zero and edges have weight 1. Nodes are added in map iteration order.
+func FromAdjacency[K comparable, V any](co *maps.MapCollection[K, []K], directed bool) *Graph[K, V] {
+	return fromAdjacency[K, V](co.All(), directed)
+}
+
+// FromMultiMap is FromAdjacency for a MultiMapCollection.
+func FromMultiMap[K comparable, V any](co *maps.MultiMapCollection[K, K], directed bool) *Graph[K, V] {
+	return fromAdjacency[K, V](co.All(), directed)
+}
+
+func fromAdjacency[K comparable, V any](items map[K][]K, directed bool) *Graph[K, V] {
+	g := NewGraph[K, V](directed)
+	var zero V
+	for from, tos := range items {
+		g.AddNode(from, zero)
+		for _, to := range tos {
+			g.AddEdge(from, to)
+		}
+	}
+	return g
+}
+
+func (g *Graph[K, V]) Directed() bool {
+	return g.directed
+}
+
+func (g *Graph[K, V]) Len() int {
+	return len(g.order)
+}
+
+// AddNode adds a node or replaces the value of an existing one.
+func (g *Graph[K, V]) AddNode(key K, value V) *Graph[K, V] {
+	if _, ok := g.nodes[key]; !ok {
+		g.order = append(g.order, key)
+		g.weights[key] = map[K]float64{}
+	}
+	g.nodes[key] = value
+	return g
+}
+
+func (g *Graph[K, V]) Node(key K) (V, bool) {
+	v, ok := g.nodes[key]
+	return v, ok
+}
+
+func (g *Graph[K, V]) HasNode(key K) bool {
+	_, ok := g.nodes[key]
+	return ok
+}
+
+// RemoveNode removes the node and every edge touching it.
+func (g *Graph[K, V]) RemoveNode(key K) *Graph[K, V] {
+	if !g.HasNode(key) {
+		return g
+	}
+	for _, other := range g.order {
+		g.removeArc(other, key)
+	}
+	delete(g.nodes, key)
+	delete(g.adjacency, key)
+	delete(g.weights, key)
+	g.order = without(g.order, key)
+	return g
+}
+
+func (g *Graph[K, V]) Nodes() []K {
+	return append([]K(nil), g.order...)
+}
+
+// AddEdge connects from and to, adding missing nodes with a zero value.
+// The weight defaults to 1, adding an existing edge updates its weight.
+func (g *Graph[K, V]) AddEdge(from, to K, weight ...float64) *Graph[K, V] {
+	w := 1.0
+	if len(weight) > 0 {
+		w = weight[0]
+	}
+	var zero V
+	for _, k := range []K{from, to} {
+		if !g.HasNode(k) {
+			g.AddNode(k, zero)
+		}
+	}
+	g.addArc(from, to, w)
+	if !g.directed {
+		g.addArc(to, from, w)
+	}
+	return g
+}
+
+func (g *Graph[K, V]) RemoveEdge(from, to K) *Graph[K, V] {
+	g.removeArc(from, to)
+	if !g.directed {
+		g.removeArc(to, from)
+	}
+	return g
+}
+
+func (g *Graph[K, V]) HasEdge(from, to K) bool {
+	_, ok := g.weights[from][to]
+	return ok
+}
+
+func (g *Graph[K, V]) Weight(from, to K) (float64, bool) {
+	w, ok := g.weights[from][to]
+	return w, ok
+}
+
+// Neighbors returns the nodes reachable from key through one edge.
+func (g *Graph[K, V]) Neighbors(key K) []K {
+	return append([]K(nil), g.adjacency[key]...)
+}
+
+// Edges returns every edge, undirected edges are returned once.
+func (g *Graph[K, V]) Edges() []Edge[K] {
+	var ret []Edge[K]
+	index := g.index()
+	for _, from := range g.order {
+		for _, to := range g.adjacency[from] {
+			if !g.directed && index[to] < index[from] {
+				continue
+			}
+			ret = append(ret, Edge[K]{From: from, To: to, Weight: g.weights[from][to]})
+		}
+	}
+	return ret
+}
+
+// ToAdjacency returns the adjacency list of the graph.
+func (g *Graph[K, V]) ToAdjacency() *maps.MapCollection[K, []K] {
+	ret := make(map[K][]K, len(g.order))
+	for _, k := range g.order {
+		ret[k] = g.Neighbors(k)
+	}
+	return maps.NewMapCollection(ret)
+}
+
+func (g *Graph[K, V]) addArc(from, to K, weight float64) {
+	if _, ok := g.weights[from][to]; !ok {
+		g.adjacency[from] = append(g.adjacency[from], to)
+	}
+	g.weights[from][to] = weight
+}
+
+func (g *Graph[K, V]) removeArc(from, to K) {
+	if _, ok := g.weights[from][to]; !ok {
+		return
+	}
+	delete(g.weights[from], to)
+	g.adjacency[from] = without(g.adjacency[from], to)
+}
+
+// index returns the insertion position of every node.
+func (g *Graph[K, V]) index() map[K]int {
+	ret := make(map[K]int, len(g.order))
+	for i, k := range g.order {
+		ret[k] = i
+	}
+	return ret
+}
+
+func without[K comparable](items []K, key K) []K {
+	for i, k := range items {
+		if k == key {
+			return append(items[:i:i], items[i+1:]...)
+		}
+	}
+	return items
+}
@@ -0,0 +1,106 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/maps"
+)
+
+func TestGraph_Nodes(t *testing.T) {
+	g := NewGraph[string, int](true).AddNode("a", 1).AddNode("b", 2).AddNode("a", 3)
+	assert.Equal(t, []string{"a", "b"}, g.Nodes())
+	v, ok := g.Node("a")
+	assert.Equal(t, 3, v)
+	assert.True(t, ok)
+	_, ok = g.Node("z")
+	assert.False(t, ok)
+
+	g.AddEdge("a", "b").AddEdge("b", "c").RemoveNode("b")
+	assert.Equal(t, []string{"a", "c"}, g.Nodes())
+	assert.False(t, g.HasEdge("a", "b"))
+	assert.Equal(t, 2, g.Len())
+}
+
+func TestGraph_Edges(t *testing.T) {
+	directed := NewGraph[string, int](true).AddEdge("a", "b", 2).AddEdge("b", "a")
+	assert.True(t, directed.HasEdge("a", "b"))
+	w, _ := directed.Weight("a", "b")
+	assert.Equal(t, float64(2), w)
+	assert.Equal(t, []Edge[string]{{From: "a", To: "b", Weight: 2}, {From: "b", To: "a", Weight: 1}}, directed.Edges())
+
+	undirected := NewGraph[string, int](false).AddEdge("a", "b", 2).AddEdge("a", "c")
+	assert.True(t, undirected.HasEdge("b", "a"))
+	assert.Equal(t, []string{"b", "c"}, undirected.Neighbors("a"))
+	assert.Equal(t, []Edge[string]{{From: "a", To: "b", Weight: 2}, {From: "a", To: "c", Weight: 1}}, undirected.Edges())
+
+	undirected.RemoveEdge("b", "a")
+	assert.False(t, undirected.HasEdge("a", "b"))
+	assert.Equal(t, []string{"c"}, undirected.Neighbors("a"))
+}
+
+func TestFromAdjacency(t *testing.T) {
+	g := FromAdjacency[string, struct{}](maps.NewMapCollection(map[string][]string{
+		"web": {"api"},
+		"api": {"db", "cache"},
+	}), true)
+	assert.ElementsMatch(t, []string{"web", "api", "db", "cache"}, g.Nodes())
+	assert.True(t, g.HasEdge("api", "cache"))
+	assert.False(t, g.HasEdge("cache", "api"))
+	assert.Equal(t, map[string][]string{"web": {"api"}, "api": {"db", "cache"}, "db": nil, "cache": nil}, g.ToAdjacency().All())
+
+	mm := maps.NewMultiMapCollection(map[string][]string{"a": {"b"}})
+	assert.True(t, FromMultiMap[string, int](mm, false).HasEdge("b", "a"))
+}
+
+func TestGraph_BFSAndDFS(t *testing.T) {
+	g := NewGraph[int, string](true).
+		AddEdge(1, 2).AddEdge(1, 3).AddEdge(2, 4).AddEdge(3, 4).AddEdge(4, 5)
+
+	var order, depths []int
+	g.BFS(1, func(k int, _ string, depth int) bool {
+		order, depths = append(order, k), append(depths, depth)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, order)
+	assert.Equal(t, []int{0, 1, 1, 2, 3}, depths)
+
+	order, depths = nil, nil
+	g.DFS(1, func(k int, _ string, depth int) bool {
+		order, depths = append(order, k), append(depths, depth)
+		return k != 5
+	})
+	assert.Equal(t, []int{1, 2, 4, 5}, order)
+	assert.Equal(t, []int{0, 1, 2, 3}, depths)
+}
+
+func TestGraph_TopologicalSort(t *testing.T) {
+	g := NewGraph[string, int](true).
+		AddEdge("config", "db").AddEdge("db", "api").AddEdge("config", "api").AddEdge("api", "web")
+	actual, err := g.TopologicalSort()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"config", "db", "api", "web"}, actual)
+	assert.Nil(t, g.FindCycle())
+
+	g.AddEdge("web", "db")
+	_, err = g.TopologicalSort()
+	assert.ErrorIs(t, err, ErrCycle)
+	assert.Equal(t, []string{"db", "api", "web", "db"}, g.FindCycle())
+
+	_, err = NewGraph[string, int](false).TopologicalSort()
+	assert.ErrorIs(t, err, ErrUndirected)
+}
+
+func TestGraph_FindCycleUndirected(t *testing.T) {
+	g := NewGraph[int, int](false).AddEdge(1, 2).AddEdge(2, 3)
+	assert.Nil(t, g.FindCycle())
+	g.AddEdge(3, 1)
+	assert.Equal(t, []int{1, 2, 3, 1}, g.FindCycle())
+	assert.Equal(t, []int{4, 4}, NewGraph[int, int](false).AddEdge(4, 4).FindCycle())
+}
+
+func TestGraph_ConnectedComponents(t *testing.T) {
+	g := NewGraph[int, int](true).AddEdge(1, 2).AddEdge(3, 2).AddNode(4, 0).AddEdge(5, 6)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4}, {5, 6}}, g.ConnectedComponents())
+	assert.Nil(t, NewGraph[int, int](false).ConnectedComponents())
+}
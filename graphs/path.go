@@ -0,0 +1,100 @@
+package graphs
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNodeNotFound   = errors.New("graphs: node not found")
+	ErrNoPath         = errors.New("graphs: no path between nodes")
+	ErrNegativeWeight = errors.New("graphs: negative edge weight")
+)
+
+// ShortestPath returns the lightest path from one node to another and its total weight
+// using Dijkstra's algorithm. Graphs with any negative edge weight are rejected with ErrNegativeWeight.
+func (g *Graph[K, V]) ShortestPath(from, to K) ([]K, float64, error) {
+	if !g.HasNode(to) {
+		return nil, 0, fmt.Errorf("%w: %v", ErrNodeNotFound, to)
+	}
+	dist, prev, err := g.dijkstra(from, &to)
+	if err != nil {
+		return nil, 0, err
+	}
+	d, ok := dist[to]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %v to %v", ErrNoPath, from, to)
+	}
+	path := []K{to}
+	for cur := to; cur != from; {
+		cur = prev[cur]
+		path = append(path, cur)
+	}
+	reverse(path)
+	return path, d, nil
+}
+
+// Distances returns the weight of the lightest path from one node to every node reachable from it.
+// Like ShortestPath, it rejects graphs with a negative edge weight.
+func (g *Graph[K, V]) Distances(from K) (map[K]float64, error) {
+	dist, _, err := g.dijkstra(from, nil)
+	return dist, err
+}
+
+// dijkstra settles nodes in order of distance, stopping early once target is settled.
+func (g *Graph[K, V]) dijkstra(from K, target *K) (map[K]float64, map[K]K, error) {
+	if !g.HasNode(from) {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNodeNotFound, from)
+	}
+	// check every edge up front, checking while relaxing misses edges past an early exit
+	// and edges that are not reachable.
+	for _, k := range g.order {
+		for _, next := range g.adjacency[k] {
+			if g.weights[k][next] < 0 {
+				return nil, nil, fmt.Errorf("%w: %v to %v", ErrNegativeWeight, k, next)
+			}
+		}
+	}
+	dist := map[K]float64{from: 0}
+	prev := map[K]K{}
+	settled := map[K]bool{}
+	queue := &distanceHeap[K]{{key: from}}
+	for queue.Len() > 0 {
+		cur := heap.Pop(queue).(distanceItem[K])
+		if settled[cur.key] {
+			continue
+		}
+		settled[cur.key] = true
+		if target != nil && cur.key == *target {
+			break
+		}
+		for _, next := range g.adjacency[cur.key] {
+			w := g.weights[cur.key][next]
+			if d, ok := dist[next]; !settled[next] && (!ok || cur.dist+w < d) {
+				dist[next] = cur.dist + w
+				prev[next] = cur.key
+				heap.Push(queue, distanceItem[K]{key: next, dist: cur.dist + w})
+			}
+		}
+	}
+	return dist, prev, nil
+}
+
+type distanceItem[K comparable] struct {
+	key  K
+	dist float64
+}
+
+type distanceHeap[K comparable] []distanceItem[K]
+
+func (h distanceHeap[K]) Len() int           { return len(h) }
+func (h distanceHeap[K]) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h distanceHeap[K]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *distanceHeap[K]) Push(x any)        { *h = append(*h, x.(distanceItem[K])) }
+func (h *distanceHeap[K]) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,48 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := NewGraph[string, int](true).
+		AddEdge("a", "b", 4).AddEdge("a", "c", 1).AddEdge("c", "b", 2).AddEdge("b", "d", 1).AddNode("e", 0)
+
+	path, dist, err := g.ShortestPath("a", "d")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "c", "b", "d"}, path)
+	assert.Equal(t, float64(4), dist)
+
+	path, dist, err = g.ShortestPath("a", "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a"}, path)
+	assert.Equal(t, float64(0), dist)
+
+	_, _, err = g.ShortestPath("a", "e")
+	assert.ErrorIs(t, err, ErrNoPath)
+	_, _, err = g.ShortestPath("a", "z")
+	assert.ErrorIs(t, err, ErrNodeNotFound)
+	_, _, err = g.ShortestPath("z", "a")
+	assert.ErrorIs(t, err, ErrNodeNotFound)
+
+	_, _, err = g.AddEdge("c", "d", -5).ShortestPath("a", "d")
+	assert.ErrorIs(t, err, ErrNegativeWeight)
+
+	// the negative edge lies past the point where the search reaches its target.
+	early := NewGraph[string, int](true).AddEdge("A", "B", 1).AddEdge("A", "C", 2).AddEdge("C", "B", -5)
+	_, _, err = early.ShortestPath("A", "B")
+	assert.ErrorIs(t, err, ErrNegativeWeight)
+
+	unreachable := NewGraph[string, int](true).AddEdge("A", "B", 1).AddEdge("X", "Y", -1)
+	_, err = unreachable.Distances("A")
+	assert.ErrorIs(t, err, ErrNegativeWeight)
+}
+
+func TestGraph_Distances(t *testing.T) {
+	g := NewGraph[int, int](false).AddEdge(1, 2, 1).AddEdge(2, 3, 1).AddEdge(1, 3, 5).AddNode(4, 0)
+	actual, err := g.Distances(1)
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]float64{1: 0, 2: 1, 3: 2}, actual)
+}
@@ -0,0 +1,202 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrCycle      = errors.New("graphs: graph has a cycle")
+	ErrUndirected = errors.New("graphs: operation requires a directed graph")
+)
+
+// BFS visits the nodes reachable from start in breadth-first order with their depth,
+// returning false from fn stops the traversal.
+func (g *Graph[K, V]) BFS(start K, fn func(K, V, int) bool) *Graph[K, V] {
+	if !g.HasNode(start) {
+		return g
+	}
+	type item struct {
+		key   K
+		depth int
+	}
+	visited := map[K]bool{start: true}
+	queue := []item{{start, 0}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if !fn(cur.key, g.nodes[cur.key], cur.depth) {
+			return g
+		}
+		for _, next := range g.adjacency[cur.key] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, item{next, cur.depth + 1})
+			}
+		}
+	}
+	return g
+}
+
+// DFS visits the nodes reachable from start in depth-first preorder with their depth,
+// returning false from fn stops the traversal.
+func (g *Graph[K, V]) DFS(start K, fn func(K, V, int) bool) *Graph[K, V] {
+	if !g.HasNode(start) {
+		return g
+	}
+	type item struct {
+		key   K
+		depth int
+	}
+	visited := map[K]bool{}
+	stack := []item{{start, 0}}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[cur.key] {
+			continue
+		}
+		visited[cur.key] = true
+		if !fn(cur.key, g.nodes[cur.key], cur.depth) {
+			return g
+		}
+		neighbors := g.adjacency[cur.key]
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if !visited[neighbors[i]] {
+				stack = append(stack, item{neighbors[i], cur.depth + 1})
+			}
+		}
+	}
+	return g
+}
+
+// TopologicalSort orders the nodes so every edge points forward. Ties keep insertion order.
+// The error wraps ErrCycle and names the cycle if the graph is not acyclic.
+func (g *Graph[K, V]) TopologicalSort() ([]K, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+	inDegree := make(map[K]int, len(g.order))
+	for _, from := range g.order {
+		for _, to := range g.adjacency[from] {
+			inDegree[to]++
+		}
+	}
+
+	var queue []K
+	for _, k := range g.order {
+		if inDegree[k] == 0 {
+			queue = append(queue, k)
+		}
+	}
+	ret := make([]K, 0, len(g.order))
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		ret = append(ret, cur)
+		for _, next := range g.adjacency[cur] {
+			if inDegree[next]--; inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+	if len(ret) < len(g.order) {
+		return nil, fmt.Errorf("%w: %v", ErrCycle, g.FindCycle())
+	}
+	return ret, nil
+}
+
+// FindCycle returns a cycle as a path that starts and ends at the same node, or nil if there is none.
+// In an undirected graph an edge is not considered a cycle with itself unless it is a self-loop.
+func (g *Graph[K, V]) FindCycle() []K {
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make(map[K]int, len(g.order))
+	parent := map[K]K{}
+
+	var cycle []K
+	var visit func(k K, hasParent bool) bool
+	visit = func(k K, hasParent bool) bool {
+		state[k] = active
+		skippedParent := false
+		for _, next := range g.adjacency[k] {
+			if !g.directed && hasParent && !skippedParent && next == parent[k] && next != k {
+				skippedParent = true
+				continue
+			}
+			switch state[next] {
+			case unvisited:
+				parent[next] = k
+				if visit(next, true) {
+					return true
+				}
+			case active:
+				cycle = []K{next}
+				for cur := k; cur != next; cur = parent[cur] {
+					cycle = append(cycle, cur)
+				}
+				cycle = append(cycle, next)
+				reverse(cycle)
+				return true
+			}
+		}
+		state[k] = done
+		return false
+	}
+	for _, k := range g.order {
+		if state[k] == unvisited && visit(k, false) {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// ConnectedComponents groups the nodes that are connected ignoring edge direction,
+// so directed graphs yield their weakly connected components. Components and their
+// nodes are in insertion order.
+func (g *Graph[K, V]) ConnectedComponents() [][]K {
+	index := g.index()
+	parent := make([]int, len(g.order))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for _, from := range g.order {
+		for _, to := range g.adjacency[from] {
+			a, b := find(index[from]), find(index[to])
+			if a > b {
+				a, b = b, a
+			}
+			parent[b] = a
+		}
+	}
+
+	var ret [][]K
+	component := map[int]int{}
+	for i, k := range g.order {
+		root := find(i)
+		c, ok := component[root]
+		if !ok {
+			c = len(ret)
+			component[root] = c
+			ret = append(ret, nil)
+		}
+		ret[c] = append(ret[c], k)
+	}
+	return ret
+}
+
+func reverse[K any](items []K) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
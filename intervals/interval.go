@@ -0,0 +1,272 @@
+// Package intervals stores half-open [Start, End) intervals in an augmented AVL tree,
+// answering point and overlap queries in O(log n + k).
+package intervals
+
+import (
+	"errors"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+var ErrEmptyInterval = errors.New("intervals: start must be before end")
+
+type Interval[T go_collection.Ordered, V any] struct {
+	Start T
+	End   T
+	Value V
+}
+
+func (iv Interval[T, V]) Contains(point T) bool {
+	return iv.Start <= point && point < iv.End
+}
+
+func (iv Interval[T, V]) Overlaps(start, end T) bool {
+	return iv.Start < end && start < iv.End
+}
+
+type IntervalCollection[T go_collection.Ordered, V any] struct {
+	root *node[T, V]
+	size int
+}
+
+type node[T go_collection.Ordered, V any] struct {
+	iv          Interval[T, V]
+	maxEnd      T
+	height      int
+	left, right *node[T, V]
+}
+
+func NewIntervalCollection[T go_collection.Ordered, V any](v []Interval[T, V]) (*IntervalCollection[T, V], error) {
+	co := &IntervalCollection[T, V]{}
+	for _, iv := range v {
+		if err := co.Insert(iv.Start, iv.End, iv.Value); err != nil {
+			return nil, err
+		}
+	}
+	return co, nil
+}
+
+func (co *IntervalCollection[T, V]) Len() int {
+	return co.size
+}
+
+func (co *IntervalCollection[T, V]) Empty() bool {
+	return co.size == 0
+}
+
+func (co *IntervalCollection[T, V]) Insert(start, end T, value V) error {
+	if !(start < end) {
+		return ErrEmptyInterval
+	}
+	co.root = co.root.insert(Interval[T, V]{Start: start, End: end, Value: value})
+	co.size++
+	return nil
+}
+
+// Delete removes one interval with exactly these bounds.
+func (co *IntervalCollection[T, V]) Delete(start, end T) (value V, _ bool) {
+	var removed *node[T, V]
+	co.root, removed = co.root.delete(start, end)
+	if removed == nil {
+		return value, false
+	}
+	co.size--
+	return removed.iv.Value, true
+}
+
+// At returns the intervals containing point, ordered by start.
+func (co *IntervalCollection[T, V]) At(point T) []Interval[T, V] {
+	var ret []Interval[T, V]
+	co.root.query(point, func(iv Interval[T, V]) bool { return point < iv.Start }, func(iv Interval[T, V]) {
+		if iv.Contains(point) {
+			ret = append(ret, iv)
+		}
+	})
+	return ret
+}
+
+// Overlapping returns the intervals overlapping [start, end), ordered by start.
+func (co *IntervalCollection[T, V]) Overlapping(start, end T) []Interval[T, V] {
+	var ret []Interval[T, V]
+	co.root.query(start, func(iv Interval[T, V]) bool { return !(iv.Start < end) }, func(iv Interval[T, V]) {
+		if iv.Overlaps(start, end) {
+			ret = append(ret, iv)
+		}
+	})
+	return ret
+}
+
+// Each visits the intervals ordered by start then end, until fn returns false.
+func (co *IntervalCollection[T, V]) Each(fn func(Interval[T, V], int) bool) *IntervalCollection[T, V] {
+	i := 0
+	co.root.each(func(iv Interval[T, V]) bool {
+		ok := fn(iv, i)
+		i++
+		return ok
+	})
+	return co
+}
+
+func (co *IntervalCollection[T, V]) All() []Interval[T, V] {
+	ret := make([]Interval[T, V], 0, co.size)
+	co.Each(func(iv Interval[T, V], _ int) bool {
+		ret = append(ret, iv)
+		return true
+	})
+	return ret
+}
+
+func (co *IntervalCollection[T, V]) ToSliceCollection() *slices.SliceCollection[Interval[T, V]] {
+	return slices.NewSliceCollection(co.All())
+}
+
+// Merge returns a new collection where overlapping or adjacent intervals are joined,
+// combining their values with fn in start order.
+func (co *IntervalCollection[T, V]) Merge(fn func(a, b V) V) *IntervalCollection[T, V] {
+	var merged []Interval[T, V]
+	for _, iv := range co.All() {
+		if last := len(merged) - 1; last >= 0 && !(merged[last].End < iv.Start) {
+			if merged[last].End < iv.End {
+				merged[last].End = iv.End
+			}
+			merged[last].Value = fn(merged[last].Value, iv.Value)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+
+	ret := &IntervalCollection[T, V]{}
+	for _, iv := range merged {
+		_ = ret.Insert(iv.Start, iv.End, iv.Value)
+	}
+	return ret
+}
+
+func less[T go_collection.Ordered](s1, e1, s2, e2 T) bool {
+	return s1 < s2 || (s1 == s2 && e1 < e2)
+}
+
+func (n *node[T, V]) insert(iv Interval[T, V]) *node[T, V] {
+	if n == nil {
+		return &node[T, V]{iv: iv, maxEnd: iv.End, height: 1}
+	}
+	if less(iv.Start, iv.End, n.iv.Start, n.iv.End) {
+		n.left = n.left.insert(iv)
+	} else {
+		n.right = n.right.insert(iv)
+	}
+	return n.balance()
+}
+
+func (n *node[T, V]) delete(start, end T) (*node[T, V], *node[T, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	var removed *node[T, V]
+	switch {
+	case less(start, end, n.iv.Start, n.iv.End):
+		n.left, removed = n.left.delete(start, end)
+	case less(n.iv.Start, n.iv.End, start, end):
+		n.right, removed = n.right.delete(start, end)
+	default:
+		removed = &node[T, V]{iv: n.iv}
+		if n.left == nil {
+			return n.right, removed
+		}
+		if n.right == nil {
+			return n.left, removed
+		}
+		var successor *node[T, V]
+		n.right, successor = n.right.deleteMin()
+		n.iv = successor.iv
+	}
+	if removed == nil {
+		return n, nil
+	}
+	return n.balance(), removed
+}
+
+func (n *node[T, V]) deleteMin() (*node[T, V], *node[T, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *node[T, V]
+	n.left, min = n.left.deleteMin()
+	return n.balance(), min
+}
+
+// query walks the tree in order, skipping subtrees whose intervals all end at or before low
+// and everything after the first interval for which past reports true.
+func (n *node[T, V]) query(low T, past func(Interval[T, V]) bool, emit func(Interval[T, V])) {
+	if n == nil || !(low < n.maxEnd) {
+		return
+	}
+	n.left.query(low, past, emit)
+	if past(n.iv) {
+		return
+	}
+	emit(n.iv)
+	n.right.query(low, past, emit)
+}
+
+func (n *node[T, V]) each(fn func(Interval[T, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.each(fn) && fn(n.iv) && n.right.each(fn)
+}
+
+func (n *node[T, V]) heightOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[T, V]) update() {
+	n.height = n.left.heightOf() + 1
+	if h := n.right.heightOf() + 1; h > n.height {
+		n.height = h
+	}
+	n.maxEnd = n.iv.End
+	if n.left != nil && n.maxEnd < n.left.maxEnd {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.maxEnd < n.right.maxEnd {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func (n *node[T, V]) balance() *node[T, V] {
+	n.update()
+	switch factor := n.left.heightOf() - n.right.heightOf(); {
+	case factor > 1:
+		if n.left.left.heightOf() < n.left.right.heightOf() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.heightOf() < n.right.left.heightOf() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *node[T, V]) rotateLeft() *node[T, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *node[T, V]) rotateRight() *node[T, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
@@ -0,0 +1,107 @@
+package intervals
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type iv = Interval[int, string]
+
+func TestNewIntervalCollection(t *testing.T) {
+	actual, err := NewIntervalCollection([]iv{{5, 8, "b"}, {1, 3, "a"}, {5, 6, "c"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []iv{{1, 3, "a"}, {5, 6, "c"}, {5, 8, "b"}}, actual.All())
+	assert.Equal(t, 3, actual.Len())
+
+	_, err = NewIntervalCollection([]iv{{3, 3, "empty"}})
+	assert.Equal(t, ErrEmptyInterval, err)
+}
+
+func TestIntervalCollection_Delete(t *testing.T) {
+	data, _ := NewIntervalCollection([]iv{{1, 3, "a"}, {1, 3, "b"}, {2, 4, "c"}})
+	_, ok := data.Delete(1, 3)
+	assert.Equal(t, true, ok)
+	_, ok = data.Delete(1, 3)
+	assert.Equal(t, true, ok)
+	_, ok = data.Delete(1, 3)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, []iv{{2, 4, "c"}}, data.All())
+}
+
+func TestIntervalCollection_At(t *testing.T) {
+	data, _ := NewIntervalCollection([]iv{{1, 5, "a"}, {3, 4, "b"}, {5, 9, "c"}})
+	assert.Equal(t, []iv{{1, 5, "a"}, {3, 4, "b"}}, data.At(3))
+	assert.Equal(t, []iv{{5, 9, "c"}}, data.At(5))
+	assert.Nil(t, data.At(9))
+}
+
+func TestIntervalCollection_Overlapping(t *testing.T) {
+	data, _ := NewIntervalCollection([]iv{{1, 5, "a"}, {3, 4, "b"}, {5, 9, "c"}, {10, 12, "d"}})
+	assert.Equal(t, []iv{{1, 5, "a"}, {3, 4, "b"}, {5, 9, "c"}}, data.Overlapping(3, 6))
+	assert.Equal(t, []iv{{5, 9, "c"}}, data.Overlapping(5, 10))
+	assert.Nil(t, data.Overlapping(9, 10))
+}
+
+func TestIntervalCollection_Merge(t *testing.T) {
+	data, _ := NewIntervalCollection([]iv{{1, 3, "a"}, {2, 5, "b"}, {5, 6, "c"}, {8, 9, "d"}, {4, 5, "e"}})
+	actual := data.Merge(func(a, b string) string { return a + b }).All()
+	assert.Equal(t, []iv{{1, 6, "abec"}, {8, 9, "d"}}, actual)
+}
+
+func TestIntervalCollection_EachAndToSliceCollection(t *testing.T) {
+	data, _ := NewIntervalCollection([]iv{{3, 4, "b"}, {1, 2, "a"}})
+	var actual []string
+	data.Each(func(i iv, _ int) bool {
+		actual = append(actual, i.Value)
+		return false
+	})
+	assert.Equal(t, []string{"a"}, actual)
+	assert.Equal(t, 2, data.ToSliceCollection().Len())
+}
+
+func TestIntervalCollection_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := &IntervalCollection[int, int]{}
+	var brute []Interval[int, int]
+	for i := 0; i < 500; i++ {
+		start := r.Intn(1000)
+		end := start + 1 + r.Intn(50)
+		assert.Nil(t, data.Insert(start, end, i))
+		brute = append(brute, Interval[int, int]{Start: start, End: end, Value: i})
+
+		if i%3 == 0 {
+			victim := brute[r.Intn(len(brute))]
+			_, ok := data.Delete(victim.Start, victim.End)
+			assert.Equal(t, true, ok)
+			for j, b := range brute {
+				if b.Start == victim.Start && b.End == victim.End {
+					brute = append(brute[:j], brute[j+1:]...)
+					break
+				}
+			}
+		}
+	}
+	assert.Equal(t, len(brute), data.Len())
+
+	for q := 0; q < 200; q++ {
+		start := r.Intn(1100)
+		end := start + 1 + r.Intn(30)
+		expected := 0
+		for _, b := range brute {
+			if b.Overlaps(start, end) {
+				expected++
+			}
+		}
+		assert.Equal(t, expected, len(data.Overlapping(start, end)))
+
+		expected = 0
+		for _, b := range brute {
+			if b.Contains(start) {
+				expected++
+			}
+		}
+		assert.Equal(t, expected, len(data.At(start)))
+	}
+}
@@ -0,0 +1,124 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrBiMapValueExists = errors.New("maps: value already bound to another key")
+
+// BiMap keeps a one to one mapping between keys and values, both sides can be looked up.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewBiMap returns an error when v maps two keys to the same value.
+func NewBiMap[K, V comparable](v map[K]V) (*BiMap[K, V], error) {
+	co := &BiMap[K, V]{forward: make(map[K]V, len(v)), inverse: make(map[V]K, len(v))}
+	for k, value := range v {
+		if err := co.Put(k, value); err != nil {
+			return nil, err
+		}
+	}
+	return co, nil
+}
+
+// All returns a copy, writing to the live map would desync the inverse side.
+func (co *BiMap[K, V]) All() map[K]V {
+	ret := make(map[K]V, len(co.forward))
+	for k, v := range co.forward {
+		ret[k] = v
+	}
+	return ret
+}
+
+func (co *BiMap[K, V]) Count() int {
+	return len(co.forward)
+}
+
+func (co *BiMap[K, V]) Empty() bool {
+	return co.Count() == 0
+}
+
+func (co *BiMap[K, V]) Keys() (keys []K) {
+	for k := range co.forward {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (co *BiMap[K, V]) Values() (values []V) {
+	for v := range co.inverse {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (co *BiMap[K, V]) Has(key K) bool {
+	_, ok := co.forward[key]
+	return ok
+}
+
+func (co *BiMap[K, V]) HasValue(value V) bool {
+	_, ok := co.inverse[value]
+	return ok
+}
+
+func (co *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := co.forward[key]
+	return v, ok
+}
+
+func (co *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := co.inverse[value]
+	return k, ok
+}
+
+// Put binds key to value, replacing the previous value of key.
+// It fails when value is already bound to a different key, use ForcePut to steal it.
+// Unlike MapCollection.Put it returns an error instead of the BiMap, since a conflict
+// dropped silently in a chain would lose the write. ForcePut is the chaining form.
+func (co *BiMap[K, V]) Put(key K, value V) error {
+	if k, ok := co.inverse[value]; ok && k != key {
+		return fmt.Errorf("%w: %v is bound to %v", ErrBiMapValueExists, value, k)
+	}
+	co.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut binds key to value, removing any previous mapping of key or of value.
+func (co *BiMap[K, V]) ForcePut(key K, value V) *BiMap[K, V] {
+	co.PullValue(value)
+	co.Pull(key)
+	co.forward[key] = value
+	co.inverse[value] = key
+	return co
+}
+
+func (co *BiMap[K, V]) Pull(key K) (V, bool) {
+	v, ok := co.forward[key]
+	if ok {
+		delete(co.forward, key)
+		delete(co.inverse, v)
+	}
+	return v, ok
+}
+
+func (co *BiMap[K, V]) PullValue(value V) (K, bool) {
+	k, ok := co.inverse[value]
+	if ok {
+		delete(co.inverse, value)
+		delete(co.forward, k)
+	}
+	return k, ok
+}
+
+// Inverse returns a view with keys and values swapped, changes to either side are visible in both.
+func (co *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: co.inverse, inverse: co.forward}
+}
+
+func (co *BiMap[K, V]) ToMapCollection() *MapCollection[K, V] {
+	return NewMapCollection(co.All())
+}
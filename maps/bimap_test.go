@@ -0,0 +1,89 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBiMap(t *testing.T) {
+	expected := map[string]int{"a": 1, "b": 2}
+	actual, err := NewBiMap(expected)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, 2, actual.Count())
+
+	_, err = NewBiMap(map[string]int{"a": 1, "b": 1})
+	assert.True(t, errors.Is(err, ErrBiMapValueExists))
+}
+
+func TestBiMap_GetAndGetKey(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1})
+	v, ok := data.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, v)
+	k, ok := data.GetKey(1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "a", k)
+	_, ok = data.GetKey(2)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, true, data.Has("a"))
+	assert.Equal(t, true, data.HasValue(1))
+	assert.ElementsMatch(t, []string{"a"}, data.Keys())
+	assert.ElementsMatch(t, []int{1}, data.Values())
+}
+
+func TestBiMap_Put(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1, "b": 2})
+	assert.Nil(t, data.Put("a", 3))
+	assert.Equal(t, false, data.HasValue(1))
+	assert.Nil(t, data.Put("a", 3))
+	assert.True(t, errors.Is(data.Put("c", 2), ErrBiMapValueExists))
+	assert.Equal(t, map[string]int{"a": 3, "b": 2}, data.All())
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1, "b": 2})
+	data.ForcePut("a", 2)
+	assert.Equal(t, map[string]int{"a": 2}, data.All())
+	assert.Equal(t, map[int]string{2: "a"}, data.Inverse().All())
+}
+
+func TestBiMap_PullAndPullValue(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1, "b": 2})
+	v, ok := data.Pull("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, v)
+	k, ok := data.PullValue(2)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "b", k)
+	assert.Equal(t, true, data.Empty())
+	assert.Equal(t, true, data.Inverse().Empty())
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1})
+	inverse := data.Inverse()
+	assert.Equal(t, map[int]string{1: "a"}, inverse.All())
+
+	assert.Nil(t, inverse.Put(2, "b"))
+	v, _ := data.Get("b")
+	assert.Equal(t, 2, v)
+	assert.Equal(t, data.All(), inverse.Inverse().All())
+}
+
+func TestBiMap_ToMapCollection(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1})
+	co := data.ToMapCollection().Put("b", 1)
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, co.All())
+	assert.Equal(t, map[string]int{"a": 1}, data.All())
+}
+
+func TestBiMap_All(t *testing.T) {
+	data, _ := NewBiMap(map[string]int{"a": 1})
+	data.All()["b"] = 2
+	assert.Equal(t, map[string]int{"a": 1}, data.All())
+	_, ok := data.GetKey(2)
+	assert.False(t, ok)
+}
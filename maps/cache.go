@@ -0,0 +1,191 @@
+package maps
+
+type EvictionPolicy int
+
+const (
+	// EvictLRU evicts the least recently used entry.
+	EvictLRU EvictionPolicy = iota
+	// EvictLFU evicts the least frequently used entry, ties go to the least recently used one.
+	EvictLFU
+	// EvictFIFO evicts the oldest inserted entry.
+	EvictFIFO
+)
+
+type CacheOptions[K comparable, V any] struct {
+	Policy EvictionPolicy
+	// Capacity bounds the total cost of the entries, 0 means unbounded.
+	Capacity int
+	// Cost returns the cost of an entry, every entry costs 1 when nil so Capacity bounds the count.
+	Cost func(K, V) int
+	// OnEvict is called for every entry evicted to make room. It is not called by Pull or Clear.
+	OnEvict func(K, V)
+}
+
+type CacheStats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// CacheCollection is a bounded map that evicts entries according to its policy.
+type CacheCollection[K comparable, V any] struct {
+	items   map[K]*cacheEntry[V]
+	policy  cachePolicy[K]
+	options CacheOptions[K, V]
+	cost    int
+	stats   CacheStats
+}
+
+type cacheEntry[V any] struct {
+	value V
+	cost  int
+}
+
+func NewCacheCollection[K comparable, V any](options CacheOptions[K, V]) *CacheCollection[K, V] {
+	co := &CacheCollection[K, V]{items: map[K]*cacheEntry[V]{}, options: options}
+	if co.options.Cost == nil {
+		co.options.Cost = func(K, V) int { return 1 }
+	}
+	switch options.Policy {
+	case EvictLFU:
+		co.policy = newLFUPolicy[K]()
+	case EvictFIFO:
+		co.policy = newOrderPolicy[K](false)
+	default:
+		co.policy = newOrderPolicy[K](true)
+	}
+	return co
+}
+
+func (co *CacheCollection[K, V]) Count() int {
+	return len(co.items)
+}
+
+func (co *CacheCollection[K, V]) Empty() bool {
+	return co.Count() == 0
+}
+
+func (co *CacheCollection[K, V]) Cost() int {
+	return co.cost
+}
+
+func (co *CacheCollection[K, V]) Capacity() int {
+	return co.options.Capacity
+}
+
+func (co *CacheCollection[K, V]) Stats() CacheStats {
+	return co.stats
+}
+
+func (co *CacheCollection[K, V]) ResetStats() *CacheCollection[K, V] {
+	co.stats = CacheStats{}
+	return co
+}
+
+func (co *CacheCollection[K, V]) Has(key K) bool {
+	_, ok := co.items[key]
+	return ok
+}
+
+// Get returns the value of key, counting a hit or a miss and marking the entry as used.
+func (co *CacheCollection[K, V]) Get(key K) (value V, _ bool) {
+	e, ok := co.items[key]
+	if !ok {
+		co.stats.Misses++
+		return value, false
+	}
+	co.stats.Hits++
+	co.policy.touch(key)
+	return e.value, true
+}
+
+// Peek returns the value of key without touching the statistics or the eviction order.
+func (co *CacheCollection[K, V]) Peek(key K) (value V, _ bool) {
+	if e, ok := co.items[key]; ok {
+		return e.value, true
+	}
+	return value, false
+}
+
+// Put stores the entry and evicts others until the cost fits the capacity.
+// An entry that alone exceeds the capacity is evicted right away. When it overwrites
+// an existing entry, that old entry is removed and reported as the eviction instead.
+func (co *CacheCollection[K, V]) Put(key K, value V) *CacheCollection[K, V] {
+	cost := co.options.Cost(key, value)
+	if co.options.Capacity > 0 && cost > co.options.Capacity {
+		if old, ok := co.Pull(key); ok {
+			value = old
+		}
+		co.evicted(key, value)
+		return co
+	}
+
+	if e, ok := co.items[key]; ok {
+		co.cost -= e.cost
+		e.value, e.cost = value, cost
+		co.policy.touch(key)
+	} else {
+		co.items[key] = &cacheEntry[V]{value: value, cost: cost}
+		co.policy.add(key)
+	}
+	co.cost += cost
+
+	for co.options.Capacity > 0 && co.cost > co.options.Capacity {
+		victim, ok := co.policy.victim(key)
+		if !ok {
+			break
+		}
+		v, _ := co.Pull(victim)
+		co.evicted(victim, v)
+	}
+	return co
+}
+
+func (co *CacheCollection[K, V]) Pull(key K) (value V, _ bool) {
+	e, ok := co.items[key]
+	if !ok {
+		return value, false
+	}
+	delete(co.items, key)
+	co.policy.remove(key)
+	co.cost -= e.cost
+	return e.value, true
+}
+
+// Keys returns the keys in eviction order, the next victim first.
+func (co *CacheCollection[K, V]) Keys() []K {
+	return co.policy.keys()
+}
+
+func (co *CacheCollection[K, V]) All() map[K]V {
+	ret := make(map[K]V, len(co.items))
+	for k, e := range co.items {
+		ret[k] = e.value
+	}
+	return ret
+}
+
+func (co *CacheCollection[K, V]) ToMapCollection() *MapCollection[K, V] {
+	return NewMapCollection(co.All())
+}
+
+func (co *CacheCollection[K, V]) Clear() *CacheCollection[K, V] {
+	for k := range co.items {
+		co.Pull(k)
+	}
+	return co
+}
+
+func (co *CacheCollection[K, V]) evicted(key K, value V) {
+	co.stats.Evictions++
+	if co.options.OnEvict != nil {
+		co.options.OnEvict(key, value)
+	}
+}
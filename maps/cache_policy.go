@@ -0,0 +1,148 @@
+package maps
+
+import (
+	"container/list"
+	"sort"
+)
+
+type cachePolicy[K comparable] interface {
+	add(key K)
+	touch(key K)
+	remove(key K)
+	// victim returns the next key to evict, never returning keep.
+	victim(keep K) (K, bool)
+	keys() []K
+}
+
+// orderPolicy implements LRU when touching moves a key to the back, FIFO otherwise.
+type orderPolicy[K comparable] struct {
+	order       *list.List
+	elems       map[K]*list.Element
+	moveOnTouch bool
+}
+
+func newOrderPolicy[K comparable](moveOnTouch bool) *orderPolicy[K] {
+	return &orderPolicy[K]{order: list.New(), elems: map[K]*list.Element{}, moveOnTouch: moveOnTouch}
+}
+
+func (p *orderPolicy[K]) add(key K) {
+	p.elems[key] = p.order.PushBack(key)
+}
+
+func (p *orderPolicy[K]) touch(key K) {
+	if p.moveOnTouch {
+		p.order.MoveToBack(p.elems[key])
+	}
+}
+
+func (p *orderPolicy[K]) remove(key K) {
+	p.order.Remove(p.elems[key])
+	delete(p.elems, key)
+}
+
+func (p *orderPolicy[K]) victim(keep K) (ret K, _ bool) {
+	for e := p.order.Front(); e != nil; e = e.Next() {
+		if k := e.Value.(K); k != keep {
+			return k, true
+		}
+	}
+	return ret, false
+}
+
+func (p *orderPolicy[K]) keys() []K {
+	ret := make([]K, 0, p.order.Len())
+	for e := p.order.Front(); e != nil; e = e.Next() {
+		ret = append(ret, e.Value.(K))
+	}
+	return ret
+}
+
+// lfuPolicy keeps one LRU list per use count.
+type lfuPolicy[K comparable] struct {
+	nodes map[K]*lfuNode
+	freqs map[int]*list.List
+	// min is the smallest use count, 0 when it has to be looked up again.
+	min int
+}
+
+type lfuNode struct {
+	freq int
+	elem *list.Element
+}
+
+func newLFUPolicy[K comparable]() *lfuPolicy[K] {
+	return &lfuPolicy[K]{nodes: map[K]*lfuNode{}, freqs: map[int]*list.List{}}
+}
+
+func (p *lfuPolicy[K]) add(key K) {
+	p.nodes[key] = &lfuNode{freq: 1, elem: p.push(1, key)}
+	p.min = 1
+}
+
+func (p *lfuPolicy[K]) touch(key K) {
+	n := p.nodes[key]
+	p.detach(n)
+	n.freq++
+	n.elem = p.push(n.freq, key)
+}
+
+func (p *lfuPolicy[K]) remove(key K) {
+	p.detach(p.nodes[key])
+	delete(p.nodes, key)
+}
+
+func (p *lfuPolicy[K]) victim(keep K) (ret K, _ bool) {
+	if l, ok := p.freqs[p.min]; ok && l.Front().Value.(K) != keep {
+		return l.Front().Value.(K), true
+	}
+	for _, freq := range p.sortedFreqs() {
+		for e := p.freqs[freq].Front(); e != nil; e = e.Next() {
+			if k := e.Value.(K); k != keep {
+				return k, true
+			}
+		}
+	}
+	return ret, false
+}
+
+func (p *lfuPolicy[K]) keys() []K {
+	ret := make([]K, 0, len(p.nodes))
+	for _, freq := range p.sortedFreqs() {
+		for e := p.freqs[freq].Front(); e != nil; e = e.Next() {
+			ret = append(ret, e.Value.(K))
+		}
+	}
+	return ret
+}
+
+func (p *lfuPolicy[K]) push(freq int, key K) *list.Element {
+	l, ok := p.freqs[freq]
+	if !ok {
+		l = list.New()
+		p.freqs[freq] = l
+	}
+	return l.PushBack(key)
+}
+
+func (p *lfuPolicy[K]) detach(n *lfuNode) {
+	l := p.freqs[n.freq]
+	l.Remove(n.elem)
+	if l.Len() == 0 {
+		delete(p.freqs, n.freq)
+		if p.min == n.freq {
+			p.min = 0
+		}
+	}
+}
+
+func (p *lfuPolicy[K]) sortedFreqs() []int {
+	freqs := make([]int, 0, len(p.freqs))
+	for f := range p.freqs {
+		freqs = append(freqs, f)
+	}
+	sort.Ints(freqs)
+	if len(freqs) > 0 {
+		p.min = freqs[0]
+	}
+	return freqs
+}
@@ -0,0 +1,110 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheCollection_LRU(t *testing.T) {
+	var evicted []string
+	co := NewCacheCollection(CacheOptions[string, int]{
+		Policy:   EvictLRU,
+		Capacity: 2,
+		OnEvict:  func(k string, _ int) { evicted = append(evicted, k) },
+	})
+	co.Put("a", 1).Put("b", 2)
+	co.Get("a")
+	co.Put("c", 3)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"a", "c"}, co.Keys())
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, co.All())
+}
+
+func TestCacheCollection_LFU(t *testing.T) {
+	co := NewCacheCollection(CacheOptions[string, int]{Policy: EvictLFU, Capacity: 3})
+	co.Put("a", 1).Put("b", 2).Put("c", 3)
+	co.Get("a")
+	co.Get("a")
+	co.Get("c")
+	assert.Equal(t, []string{"b", "c", "a"}, co.Keys())
+
+	co.Put("d", 4)
+	assert.Equal(t, false, co.Has("b"))
+	co.Put("e", 5)
+	assert.Equal(t, false, co.Has("d"))
+	assert.Equal(t, []string{"e", "c", "a"}, co.Keys())
+}
+
+func TestCacheCollection_FIFO(t *testing.T) {
+	co := NewCacheCollection(CacheOptions[string, int]{Policy: EvictFIFO, Capacity: 2})
+	co.Put("a", 1).Put("b", 2)
+	co.Get("a")
+	co.Put("a", 10)
+	co.Put("c", 3)
+	assert.Equal(t, map[string]int{"b": 2, "c": 3}, co.All())
+}
+
+func TestCacheCollection_Cost(t *testing.T) {
+	var evicted []string
+	co := NewCacheCollection(CacheOptions[string, string]{
+		Capacity: 10,
+		Cost:     func(_ string, v string) int { return len(v) },
+		OnEvict:  func(k string, _ string) { evicted = append(evicted, k) },
+	})
+	co.Put("a", "xxxx").Put("b", "xxxx")
+	assert.Equal(t, 8, co.Cost())
+	co.Put("c", "xxxxx")
+	assert.Equal(t, []string{"a"}, evicted)
+	assert.Equal(t, 9, co.Cost())
+
+	co.Put("big", "xxxxxxxxxxxx")
+	assert.Equal(t, false, co.Has("big"))
+	assert.Equal(t, []string{"a", "big"}, evicted)
+
+	co.Put("b", "x")
+	assert.Equal(t, 6, co.Cost())
+	assert.Equal(t, 10, co.Capacity())
+
+	var evictedValues []string
+	co = NewCacheCollection(CacheOptions[string, string]{
+		Capacity: 10,
+		Cost:     func(_ string, v string) int { return len(v) },
+		OnEvict:  func(_ string, v string) { evictedValues = append(evictedValues, v) },
+	})
+	co.Put("a", "old").Put("a", "xxxxxxxxxxxx")
+	assert.Equal(t, false, co.Has("a"))
+	assert.Equal(t, []string{"old"}, evictedValues)
+	assert.Equal(t, 0, co.Cost())
+	assert.Equal(t, 1, co.Stats().Evictions)
+}
+
+func TestCacheCollection_Stats(t *testing.T) {
+	co := NewCacheCollection(CacheOptions[string, int]{Capacity: 1})
+	co.Put("a", 1)
+	co.Get("a")
+	co.Get("b")
+	co.Put("b", 2)
+	co.Peek("b")
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Evictions: 1}, co.Stats())
+	assert.Equal(t, 0.5, co.Stats().HitRate())
+	assert.Equal(t, CacheStats{}, co.ResetStats().Stats())
+	assert.Equal(t, 0.0, co.Stats().HitRate())
+}
+
+func TestCacheCollection_PullAndClear(t *testing.T) {
+	evictions := 0
+	co := NewCacheCollection(CacheOptions[string, int]{OnEvict: func(string, int) { evictions++ }})
+	co.Put("a", 1).Put("b", 2).Put("c", 3)
+	v, ok := co.Pull("b")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, v)
+	_, ok = co.Pull("b")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, co.ToMapCollection().All())
+
+	co.Clear()
+	assert.Equal(t, true, co.Empty())
+	assert.Equal(t, 0, co.Cost())
+	assert.Equal(t, 0, evictions)
+}
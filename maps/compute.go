@@ -0,0 +1,69 @@
+package maps
+
+func (co *MapCollection[K, V]) GetOrDefault(key K, def V) V {
+	if v, ok := co.items[key]; ok {
+		return v
+	}
+	return def
+}
+
+// GetOrPut returns the value for key, storing the result of fn first if the key is missing.
+func (co *MapCollection[K, V]) GetOrPut(key K, fn func() V) V {
+	if v, ok := co.items[key]; ok {
+		return v
+	}
+	v := fn()
+	co.items[key] = v
+	return v
+}
+
+// ComputeIfAbsent stores fn's value when key is missing and fn reports ok.
+// It returns the current value and whether key is present afterwards.
+func (co *MapCollection[K, V]) ComputeIfAbsent(key K, fn func(K) (V, bool)) (V, bool) {
+	if v, ok := co.items[key]; ok {
+		return v, true
+	}
+	v, ok := fn(key)
+	if ok {
+		co.items[key] = v
+	}
+	return v, ok
+}
+
+// ComputeIfPresent replaces the value of an existing key, the key is removed when fn reports !ok.
+func (co *MapCollection[K, V]) ComputeIfPresent(key K, fn func(K, V) (V, bool)) (value V, _ bool) {
+	old, ok := co.items[key]
+	if !ok {
+		return value, false
+	}
+	v, keep := fn(key, old)
+	return co.store(key, v, keep)
+}
+
+// Compute replaces the value of key whether or not it exists, the key is removed when fn reports !ok.
+func (co *MapCollection[K, V]) Compute(key K, fn func(K, V, bool) (V, bool)) (V, bool) {
+	old, exists := co.items[key]
+	v, keep := fn(key, old, exists)
+	return co.store(key, v, keep)
+}
+
+// Merge stores value when key is missing, otherwise it stores fn(old, value).
+// The key is removed when fn reports !ok.
+func (co *MapCollection[K, V]) Merge(key K, value V, fn func(V, V) (V, bool)) (V, bool) {
+	old, ok := co.items[key]
+	if !ok {
+		co.items[key] = value
+		return value, true
+	}
+	v, keep := fn(old, value)
+	return co.store(key, v, keep)
+}
+
+func (co *MapCollection[K, V]) store(key K, value V, ok bool) (V, bool) {
+	if !ok {
+		delete(co.items, key)
+		return value, false
+	}
+	co.items[key] = value
+	return value, true
+}
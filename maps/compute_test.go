@@ -0,0 +1,78 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapCollection_GetOrDefault(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1})
+	assert.Equal(t, 1, data.GetOrDefault("a", 9))
+	assert.Equal(t, 9, data.GetOrDefault("b", 9))
+	assert.Equal(t, false, data.Has("b"))
+}
+
+func TestMapCollection_GetOrPut(t *testing.T) {
+	calls := 0
+	data := NewMapCollection(map[string]int{"a": 1})
+	fn := func() int { calls++; return 2 }
+	assert.Equal(t, 1, data.GetOrPut("a", fn))
+	assert.Equal(t, 2, data.GetOrPut("b", fn))
+	assert.Equal(t, 2, data.GetOrPut("b", fn))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, data.All())
+}
+
+func TestMapCollection_ComputeIfAbsent(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1})
+	v, ok := data.ComputeIfAbsent("a", func(k string) (int, bool) { return 100, true })
+	assert.Equal(t, 1, v)
+	assert.Equal(t, true, ok)
+
+	v, ok = data.ComputeIfAbsent("bb", func(k string) (int, bool) { return len(k), true })
+	assert.Equal(t, 2, v)
+	assert.Equal(t, true, ok)
+
+	_, ok = data.ComputeIfAbsent("c", func(k string) (int, bool) { return 0, false })
+	assert.Equal(t, false, ok)
+	assert.Equal(t, map[string]int{"a": 1, "bb": 2}, data.All())
+}
+
+func TestMapCollection_ComputeIfPresent(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1, "b": 2})
+	v, ok := data.ComputeIfPresent("a", func(_ string, v int) (int, bool) { return v + 10, true })
+	assert.Equal(t, 11, v)
+	assert.Equal(t, true, ok)
+
+	_, ok = data.ComputeIfPresent("b", func(string, int) (int, bool) { return 0, false })
+	assert.Equal(t, false, ok)
+
+	_, ok = data.ComputeIfPresent("z", func(string, int) (int, bool) { return 1, true })
+	assert.Equal(t, false, ok)
+	assert.Equal(t, map[string]int{"a": 11}, data.All())
+}
+
+func TestMapCollection_Compute(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1})
+	inc := func(_ string, v int, _ bool) (int, bool) { return v + 1, true }
+	data.Compute("a", inc)
+	data.Compute("b", inc)
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, data.All())
+
+	_, ok := data.Compute("a", func(string, int, bool) (int, bool) { return 0, false })
+	assert.Equal(t, false, ok)
+	assert.Equal(t, map[string]int{"b": 1}, data.All())
+}
+
+func TestMapCollection_Merge(t *testing.T) {
+	sum := func(old, v int) (int, bool) { return old + v, old+v != 0 }
+	data := NewMapCollection(map[string]int{"a": 1})
+	v, _ := data.Merge("a", 5, sum)
+	assert.Equal(t, 6, v)
+	v, _ = data.Merge("b", 5, sum)
+	assert.Equal(t, 5, v)
+	_, ok := data.Merge("b", -5, sum)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, map[string]int{"a": 6}, data.All())
+}
@@ -0,0 +1,56 @@
+package maps
+
+import (
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func (co *MapCollection[K, V]) KeysCollection() *slices.SliceCollection[K] {
+	return slices.NewSliceCollection(co.Keys())
+}
+
+func (co *MapCollection[K, V]) ValuesCollection() *slices.SliceCollection[V] {
+	return slices.NewSliceCollection(co.Values())
+}
+
+// ToSliceCollection returns the entries as a SliceCollection, sorted by less when given.
+func (co *MapCollection[K, V]) ToSliceCollection(less ...func(a, b go_collection.Entry[K, V]) bool) *slices.SliceCollection[go_collection.Entry[K, V]] {
+	if len(less) > 0 {
+		return slices.NewSliceCollection(co.EntriesSortedFunc(less[0]))
+	}
+	return slices.NewSliceCollection(co.Entries())
+}
+
+func FromSlice[T any, K comparable, V any](co *slices.SliceCollection[T], key func(T, int) K, value func(T, int) V) *MapCollection[K, V] {
+	ret := make(map[K]V, co.Len())
+	co.Each(func(v T, i int) bool {
+		ret[key(v, i)] = value(v, i)
+		return true
+	})
+	return NewMapCollection(ret)
+}
+
+func KeyBy[T any, K comparable](co *slices.SliceCollection[T], key func(T, int) K) *MapCollection[K, T] {
+	return FromSlice(co, key, func(v T, _ int) T { return v })
+}
+
+func GroupBy[T any, U comparable](co *slices.SliceCollection[T], fn func(T, int) U) *MapCollection[U, *slices.SliceCollection[T]] {
+	ret := map[U]*slices.SliceCollection[T]{}
+	co.Each(func(v T, i int) bool {
+		key := fn(v, i)
+		if _, ok := ret[key]; !ok {
+			ret[key] = slices.NewSliceCollection([]T{})
+		}
+		ret[key].Push(v)
+		return true
+	})
+	return NewMapCollection(ret)
+}
+
+func ToSliceSortedByKey[K go_collection.Ordered, V any](co *MapCollection[K, V]) *slices.SliceCollection[go_collection.Entry[K, V]] {
+	return slices.NewSliceCollection(EntriesSortedByKey(co))
+}
+
+func ToSliceSortedByValue[K, V go_collection.Ordered](co *MapCollection[K, V]) *slices.SliceCollection[go_collection.Entry[K, V]] {
+	return slices.NewSliceCollection(EntriesSortedByValue(co))
+}
@@ -0,0 +1,64 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestMapCollection_KeysAndValuesCollection(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1, "b": 2})
+	assert.ElementsMatch(t, []string{"a", "b"}, data.KeysCollection().All())
+	assert.ElementsMatch(t, []int{1, 2}, data.ValuesCollection().All())
+}
+
+func TestMapCollection_ToSliceCollection(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 3, "b": 1, "c": 2})
+	expected := []go_collection.Entry[string, int]{{Key: "b", Value: 1}, {Key: "c", Value: 2}, {Key: "a", Value: 3}}
+	actual := data.ToSliceCollection(func(a, b go_collection.Entry[string, int]) bool { return a.Value < b.Value }).All()
+	assert.Equal(t, expected, actual)
+
+	assert.Equal(t, 3, data.ToSliceCollection().Len())
+}
+
+func TestFromSlice(t *testing.T) {
+	expected := map[string]int{"a": 1, "bb": 2}
+	actual := FromSlice(slices.NewSliceCollection([]string{"a", "bb"}),
+		func(v string, _ int) string { return v },
+		func(v string, _ int) int { return len(v) }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestKeyBy(t *testing.T) {
+	expected := map[string]string{"a": "abc", "b": "book"}
+	actual := KeyBy(slices.NewSliceCollection([]string{"abc", "book"}), func(s string, _ int) string { return s[0:1] }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestGroupBy(t *testing.T) {
+	actual := GroupBy(slices.NewSliceCollection([]int{0, 1, 2, 3}), func(v int, _ int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	assert.Equal(t, 2, actual.Count())
+	even, _ := actual.Get("even")
+	odd, _ := actual.Get("odd")
+	assert.Equal(t, []int{0, 2}, even.All())
+	assert.Equal(t, []int{1, 3}, odd.All())
+}
+
+func TestToSliceSortedByKey(t *testing.T) {
+	expected := []go_collection.Entry[string, int]{{Key: "a", Value: 3}, {Key: "b", Value: 1}, {Key: "c", Value: 2}}
+	actual := ToSliceSortedByKey(NewMapCollection(map[string]int{"c": 2, "a": 3, "b": 1})).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestToSliceSortedByValue(t *testing.T) {
+	expected := []go_collection.Entry[string, int]{{Key: "b", Value: 1}, {Key: "c", Value: 1}, {Key: "a", Value: 3}}
+	actual := ToSliceSortedByValue(NewMapCollection(map[string]int{"c": 1, "a": 3, "b": 1})).All()
+	assert.Equal(t, expected, actual)
+}
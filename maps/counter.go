@@ -0,0 +1,171 @@
+package maps
+
+import (
+	"sort"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// Counter is a multiset that stores how many times each item was added.
+// Items whose count drops to zero or below are removed.
+type Counter[T comparable] struct {
+	counts map[T]int
+	// seen records when an item was first counted, so ties keep insertion order.
+	seen map[T]int
+	seq  int
+}
+
+func NewCounter[T comparable](items []T) *Counter[T] {
+	co := &Counter[T]{counts: map[T]int{}, seen: map[T]int{}}
+	for _, item := range items {
+		co.Add(item)
+	}
+	return co
+}
+
+// CountBy counts the items of the collection by the key fn returns.
+func CountBy[T any, K comparable](co *slices.SliceCollection[T], fn func(T, int) K) *Counter[K] {
+	ret := NewCounter[K](nil)
+	co.Each(func(v T, i int) bool {
+		ret.Add(fn(v, i))
+		return true
+	})
+	return ret
+}
+
+func (co *Counter[T]) All() map[T]int {
+	return co.counts
+}
+
+func (co *Counter[T]) Len() int {
+	return len(co.counts)
+}
+
+func (co *Counter[T]) Empty() bool {
+	return co.Len() == 0
+}
+
+func (co *Counter[T]) Keys() []T {
+	keys := make([]T, 0, len(co.counts))
+	for k := range co.counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return co.seen[keys[i]] < co.seen[keys[j]] })
+	return keys
+}
+
+func (co *Counter[T]) Add(item T) *Counter[T] {
+	return co.AddN(item, 1)
+}
+
+// AddN adds n occurrences of item, a negative n removes occurrences.
+func (co *Counter[T]) AddN(item T, n int) *Counter[T] {
+	count := co.counts[item] + n
+	if count <= 0 {
+		delete(co.counts, item)
+		delete(co.seen, item)
+		return co
+	}
+	if _, ok := co.seen[item]; !ok {
+		co.seq++
+		co.seen[item] = co.seq
+	}
+	co.counts[item] = count
+	return co
+}
+
+// Remove removes one occurrence of item and reports whether it was present.
+func (co *Counter[T]) Remove(item T) bool {
+	if _, ok := co.counts[item]; !ok {
+		return false
+	}
+	co.AddN(item, -1)
+	return true
+}
+
+func (co *Counter[T]) Count(item T) int {
+	return co.counts[item]
+}
+
+func (co *Counter[T]) Total() (n int) {
+	for _, c := range co.counts {
+		n += c
+	}
+	return n
+}
+
+// MostCommon returns the n most common items and their counts, all items when n <= 0.
+// Items with equal counts keep the order in which they were first added.
+func (co *Counter[T]) MostCommon(n int) []go_collection.Entry[T, int] {
+	keys := co.Keys()
+	sort.SliceStable(keys, func(i, j int) bool { return co.counts[keys[i]] > co.counts[keys[j]] })
+	if n > 0 && n < len(keys) {
+		keys = keys[:n]
+	}
+
+	ret := make([]go_collection.Entry[T, int], len(keys))
+	for i, k := range keys {
+		ret[i] = go_collection.Entry[T, int]{Key: k, Value: co.counts[k]}
+	}
+	return ret
+}
+
+func (co *Counter[T]) Clone() *Counter[T] {
+	ret := NewCounter[T](nil)
+	for _, k := range co.Keys() {
+		ret.AddN(k, co.counts[k])
+	}
+	return ret
+}
+
+// Plus returns a new counter with the counts of both counters added.
+func (co *Counter[T]) Plus(other *Counter[T]) *Counter[T] {
+	ret := co.Clone()
+	for _, k := range other.Keys() {
+		ret.AddN(k, other.counts[k])
+	}
+	return ret
+}
+
+// Subtract returns a new counter with the counts of other subtracted, keeping only positive counts.
+func (co *Counter[T]) Subtract(other *Counter[T]) *Counter[T] {
+	ret := co.Clone()
+	for k, c := range other.counts {
+		ret.AddN(k, -c)
+	}
+	return ret
+}
+
+// Intersect returns a new counter with the minimum count of items present in both.
+func (co *Counter[T]) Intersect(other *Counter[T]) *Counter[T] {
+	ret := NewCounter[T](nil)
+	for _, k := range co.Keys() {
+		if c, ok := other.counts[k]; ok {
+			if co.counts[k] < c {
+				c = co.counts[k]
+			}
+			ret.AddN(k, c)
+		}
+	}
+	return ret
+}
+
+// Union returns a new counter with the maximum count of each item.
+func (co *Counter[T]) Union(other *Counter[T]) *Counter[T] {
+	ret := co.Clone()
+	for _, k := range other.Keys() {
+		if c := other.counts[k]; c > ret.counts[k] {
+			ret.AddN(k, c-ret.counts[k])
+		}
+	}
+	return ret
+}
+
+func (co *Counter[T]) ToMapCollection() *MapCollection[T, int] {
+	ret := make(map[T]int, len(co.counts))
+	for k, c := range co.counts {
+		ret[k] = c
+	}
+	return NewMapCollection(ret)
+}
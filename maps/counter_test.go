@@ -0,0 +1,66 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestNewCounter(t *testing.T) {
+	expected := map[string]int{"a": 2, "b": 1}
+	actual := NewCounter([]string{"a", "b", "a"})
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, 2, actual.Len())
+	assert.Equal(t, []string{"a", "b"}, actual.Keys())
+}
+
+func TestCountBy(t *testing.T) {
+	expected := map[int]int{1: 2, 3: 1}
+	actual := CountBy(slices.NewSliceCollection([]string{"a", "b", "abc"}), func(v string, _ int) int { return len(v) }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestCounter_AddAndRemove(t *testing.T) {
+	data := NewCounter[string](nil).Add("a").AddN("b", 3)
+	assert.Equal(t, 1, data.Count("a"))
+	assert.Equal(t, 3, data.Count("b"))
+	assert.Equal(t, 0, data.Count("z"))
+
+	assert.Equal(t, true, data.Remove("a"))
+	assert.Equal(t, false, data.Remove("a"))
+	data.AddN("b", -5)
+	assert.Equal(t, true, data.Empty())
+}
+
+func TestCounter_Total(t *testing.T) {
+	assert.Equal(t, 5, NewCounter([]int{1, 1, 2, 3, 3}).Total())
+}
+
+func TestCounter_MostCommon(t *testing.T) {
+	data := NewCounter(strings.Split("abracadabra", ""))
+	expected := []go_collection.Entry[string, int]{{Key: "a", Value: 5}, {Key: "b", Value: 2}, {Key: "r", Value: 2}}
+	assert.Equal(t, expected, data.MostCommon(3))
+	assert.Equal(t, 5, len(data.MostCommon(0)))
+	assert.Equal(t, 5, len(data.MostCommon(10)))
+}
+
+func TestCounter_Arithmetic(t *testing.T) {
+	a := NewCounter([]string{"x", "x", "x", "y"})
+	b := NewCounter([]string{"x", "y", "y", "z"})
+
+	assert.Equal(t, map[string]int{"x": 4, "y": 3, "z": 1}, a.Plus(b).All())
+	assert.Equal(t, map[string]int{"x": 2}, a.Subtract(b).All())
+	assert.Equal(t, map[string]int{"x": 1, "y": 1}, a.Intersect(b).All())
+	assert.Equal(t, map[string]int{"x": 3, "y": 2, "z": 1}, a.Union(b).All())
+	assert.Equal(t, map[string]int{"x": 3, "y": 1}, a.All())
+}
+
+func TestCounter_ToMapCollection(t *testing.T) {
+	data := NewCounter([]string{"a", "a"})
+	co := data.ToMapCollection().Put("a", 10)
+	assert.Equal(t, map[string]int{"a": 10}, co.All())
+	assert.Equal(t, 2, data.Count("a"))
+}
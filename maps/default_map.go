@@ -0,0 +1,33 @@
+package maps
+
+// DefaultMapCollection is a MapCollection whose Get creates missing values with a factory.
+// Put and Union keep the DefaultMapCollection type when chained, the other embedded methods
+// return the plain *MapCollection, whose Get does not create values.
+type DefaultMapCollection[K comparable, V any] struct {
+	*MapCollection[K, V]
+	factory func(K) V
+}
+
+func NewDefaultMapCollection[K comparable, V any](v map[K]V, factory func(K) V) *DefaultMapCollection[K, V] {
+	if v == nil {
+		v = map[K]V{}
+	}
+	return &DefaultMapCollection[K, V]{MapCollection: NewMapCollection(v), factory: factory}
+}
+
+// Get returns the value for key, storing the factory's value first if the key is missing.
+// It hides the embedded MapCollection.Get and returns V instead of (V, bool), since the
+// value is always present. Use Has or co.MapCollection.Get to look up without creating.
+func (co *DefaultMapCollection[K, V]) Get(key K) V {
+	return co.GetOrPut(key, func() V { return co.factory(key) })
+}
+
+func (co *DefaultMapCollection[K, V]) Put(key K, value V) *DefaultMapCollection[K, V] {
+	co.MapCollection.Put(key, value)
+	return co
+}
+
+func (co *DefaultMapCollection[K, V]) Union(items map[K]V) *DefaultMapCollection[K, V] {
+	co.MapCollection.Union(items)
+	return co
+}
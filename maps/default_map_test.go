@@ -0,0 +1,26 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultMapCollection_Get(t *testing.T) {
+	data := NewDefaultMapCollection(nil, func(string) []int { return []int{} })
+	data.Put("a", append(data.Get("a"), 1))
+	data.Put("a", append(data.Get("a"), 2))
+	assert.Equal(t, []int{1, 2}, data.Get("a"))
+	assert.Equal(t, []int{}, data.Get("b"))
+	assert.Equal(t, 2, data.Count())
+
+	counts := NewDefaultMapCollection(map[string]int{"x": 5}, func(k string) int { return len(k) })
+	assert.Equal(t, 5, counts.Get("x"))
+	assert.Equal(t, 3, counts.Get("abc"))
+}
+
+func TestDefaultMapCollection_Chaining(t *testing.T) {
+	data := NewDefaultMapCollection(nil, func(k string) int { return len(k) })
+	assert.Equal(t, 3, data.Put("a", 1).Union(map[string]int{"b": 2}).Get("abc"))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "abc": 3}, data.All())
+}
@@ -0,0 +1,60 @@
+package maps
+
+import (
+	"github.com/google/go-cmp/cmp"
+)
+
+type Change[V any] struct {
+	Old V
+	New V
+}
+
+// MapDiff describes how to turn one map into another.
+type MapDiff[K comparable, V any] struct {
+	Added   map[K]V
+	Removed map[K]V
+	Changed map[K]Change[V]
+}
+
+func (d *MapDiff[K, V]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffDetailed compares the collection against items, treating items as the newer version.
+// Values are compared with cmp.Equal unless an equality function is given.
+func (co *MapCollection[K, V]) DiffDetailed(items map[K]V, eq ...func(V, V) bool) *MapDiff[K, V] {
+	equal := func(a, b V) bool { return cmp.Equal(a, b) }
+	if len(eq) > 0 && eq[0] != nil {
+		equal = eq[0]
+	}
+
+	ret := &MapDiff[K, V]{
+		Added:   map[K]V{},
+		Removed: map[K]V{},
+		Changed: map[K]Change[V]{},
+	}
+	for k, old := range co.items {
+		v, ok := items[k]
+		if !ok {
+			ret.Removed[k] = old
+		} else if !equal(old, v) {
+			ret.Changed[k] = Change[V]{Old: old, New: v}
+		}
+	}
+	for k, v := range items {
+		if _, ok := co.items[k]; !ok {
+			ret.Added[k] = v
+		}
+	}
+	return ret
+}
+
+func (co *MapCollection[K, V]) Apply(diff *MapDiff[K, V]) *MapCollection[K, V] {
+	for k := range diff.Removed {
+		delete(co.items, k)
+	}
+	for k, c := range diff.Changed {
+		co.items[k] = c.New
+	}
+	return co.Union(diff.Added)
+}
@@ -0,0 +1,33 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapCollection_DiffDetailed(t *testing.T) {
+	old := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3})
+	actual := old.DiffDetailed(map[string]int{"a": 1, "b": 20, "d": 4})
+	assert.Equal(t, map[string]int{"d": 4}, actual.Added)
+	assert.Equal(t, map[string]int{"c": 3}, actual.Removed)
+	assert.Equal(t, map[string]Change[int]{"b": {Old: 2, New: 20}}, actual.Changed)
+	assert.Equal(t, false, actual.Empty())
+
+	assert.Equal(t, true, old.DiffDetailed(map[string]int{"a": 1, "b": 2, "c": 3}).Empty())
+
+	actual1 := NewMapCollection(map[string]string{"a": "x", "b": "y"}).
+		DiffDetailed(map[string]string{"a": "X", "b": "z"}, strings.EqualFold)
+	assert.Equal(t, map[string]Change[string]{"b": {Old: "y", New: "z"}}, actual1.Changed)
+}
+
+func TestMapCollection_Apply(t *testing.T) {
+	expected := map[string]int{"a": 1, "b": 20, "d": 4}
+	diff := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).DiffDetailed(expected)
+	actual := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Apply(diff).All()
+	assert.Equal(t, expected, actual)
+
+	actual = NewMapCollection(map[string]int{"c": 3, "z": 26}).Apply(diff).All()
+	assert.Equal(t, map[string]int{"b": 20, "d": 4, "z": 26}, actual)
+}
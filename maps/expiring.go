@@ -0,0 +1,201 @@
+package maps
+
+import (
+	"sync"
+	"time"
+)
+
+type ExpiringOptions[K comparable, V any] struct {
+	// Now is the clock used for expiry, time.Now when nil.
+	Now func() time.Time
+	// OnExpire is called, outside of the lock, for every expired entry that gets removed.
+	OnExpire func(K, V)
+}
+
+// ExpiringMapCollection is a map whose entries expire after a TTL. Expired entries are
+// invisible right away and are removed lazily on access, by Sweep or by the janitor.
+// It is safe for concurrent use.
+type ExpiringMapCollection[K comparable, V any] struct {
+	mu      sync.Mutex
+	items   map[K]expiringEntry[V]
+	options ExpiringOptions[K, V]
+	stop    chan struct{}
+}
+
+type expiringEntry[V any] struct {
+	value V
+	// expires is zero for entries that never expire.
+	expires time.Time
+}
+
+func NewExpiringMapCollection[K comparable, V any](options ExpiringOptions[K, V]) *ExpiringMapCollection[K, V] {
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &ExpiringMapCollection[K, V]{items: map[K]expiringEntry[V]{}, options: options}
+}
+
+// Put stores the entry for ttl, a ttl <= 0 never expires.
+func (co *ExpiringMapCollection[K, V]) Put(key K, value V, ttl time.Duration) *ExpiringMapCollection[K, V] {
+	e := expiringEntry[V]{value: value}
+	if ttl > 0 {
+		e.expires = co.options.Now().Add(ttl)
+	}
+	co.mu.Lock()
+	co.items[key] = e
+	co.mu.Unlock()
+	return co
+}
+
+func (co *ExpiringMapCollection[K, V]) Get(key K) (value V, _ bool) {
+	e, ok := co.lookup(key)
+	if !ok {
+		return value, false
+	}
+	return e.value, true
+}
+
+func (co *ExpiringMapCollection[K, V]) Has(key K) bool {
+	_, ok := co.lookup(key)
+	return ok
+}
+
+// TTL returns the time left before key expires, 0 for entries that never expire.
+func (co *ExpiringMapCollection[K, V]) TTL(key K) (time.Duration, bool) {
+	e, ok := co.lookup(key)
+	if !ok {
+		return 0, false
+	}
+	if e.expires.IsZero() {
+		return 0, true
+	}
+	return e.expires.Sub(co.options.Now()), true
+}
+
+func (co *ExpiringMapCollection[K, V]) Pull(key K) (value V, _ bool) {
+	co.mu.Lock()
+	e, ok := co.items[key]
+	if !ok {
+		co.mu.Unlock()
+		return value, false
+	}
+	delete(co.items, key)
+	expired := e.expired(co.options.Now())
+	co.mu.Unlock()
+	if expired {
+		co.expired(key, e.value)
+		return value, false
+	}
+	return e.value, true
+}
+
+func (co *ExpiringMapCollection[K, V]) Count() int {
+	return len(co.All())
+}
+
+func (co *ExpiringMapCollection[K, V]) Keys() (keys []K) {
+	for k := range co.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All returns a snapshot of the entries that have not expired.
+func (co *ExpiringMapCollection[K, V]) All() map[K]V {
+	co.mu.Lock()
+	defer co.mu.Unlock()
+	now := co.options.Now()
+	ret := make(map[K]V, len(co.items))
+	for k, e := range co.items {
+		if !e.expired(now) {
+			ret[k] = e.value
+		}
+	}
+	return ret
+}
+
+func (co *ExpiringMapCollection[K, V]) ToMapCollection() *MapCollection[K, V] {
+	return NewMapCollection(co.All())
+}
+
+// Sweep removes every expired entry and returns how many were removed.
+func (co *ExpiringMapCollection[K, V]) Sweep() int {
+	co.mu.Lock()
+	now := co.options.Now()
+	expired := map[K]V{}
+	for k, e := range co.items {
+		if e.expired(now) {
+			expired[k] = e.value
+			delete(co.items, k)
+		}
+	}
+	co.mu.Unlock()
+
+	for k, v := range expired {
+		co.expired(k, v)
+	}
+	return len(expired)
+}
+
+// StartJanitor sweeps every interval in a background goroutine until StopJanitor is called.
+// Starting an already running janitor restarts it with the new interval. It panics if interval <= 0.
+func (co *ExpiringMapCollection[K, V]) StartJanitor(interval time.Duration) *ExpiringMapCollection[K, V] {
+	if interval <= 0 {
+		panic("maps: janitor interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	stop := make(chan struct{})
+	co.mu.Lock()
+	if co.stop != nil {
+		close(co.stop)
+	}
+	co.stop = stop
+	co.mu.Unlock()
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				co.Sweep()
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return co
+}
+
+func (co *ExpiringMapCollection[K, V]) StopJanitor() *ExpiringMapCollection[K, V] {
+	co.mu.Lock()
+	defer co.mu.Unlock()
+	if co.stop != nil {
+		close(co.stop)
+		co.stop = nil
+	}
+	return co
+}
+
+// lookup returns the live entry for key, removing it when it has expired.
+func (co *ExpiringMapCollection[K, V]) lookup(key K) (expiringEntry[V], bool) {
+	co.mu.Lock()
+	e, ok := co.items[key]
+	if ok && e.expired(co.options.Now()) {
+		delete(co.items, key)
+		co.mu.Unlock()
+		co.expired(key, e.value)
+		return e, false
+	}
+	co.mu.Unlock()
+	return e, ok
+}
+
+func (co *ExpiringMapCollection[K, V]) expired(key K, value V) {
+	if co.options.OnExpire != nil {
+		co.options.OnExpire(key, value)
+	}
+}
+
+func (e expiringEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
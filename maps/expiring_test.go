@@ -0,0 +1,153 @@
+package maps
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiringMapCollection_Get(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var expired []string
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{
+		Now:      clock.Now,
+		OnExpire: func(k string, _ int) { expired = append(expired, k) },
+	})
+	co.Put("a", 1, time.Minute).Put("b", 2, 0)
+
+	v, ok := co.Get("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, v)
+
+	clock.Advance(time.Minute)
+	_, ok = co.Get("a")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, []string{"a"}, expired)
+	assert.Equal(t, true, co.Has("b"))
+}
+
+func TestExpiringMapCollection_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{Now: clock.Now})
+	co.Put("a", 1, time.Minute).Put("b", 2, 0)
+	clock.Advance(20 * time.Second)
+
+	ttl, ok := co.TTL("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 40*time.Second, ttl)
+	ttl, ok = co.TTL("b")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, time.Duration(0), ttl)
+	_, ok = co.TTL("z")
+	assert.Equal(t, false, ok)
+}
+
+func TestExpiringMapCollection_All(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{Now: clock.Now})
+	co.Put("a", 1, time.Second).Put("b", 2, time.Hour)
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, map[string]int{"b": 2}, co.All())
+	assert.Equal(t, 1, co.Count())
+	assert.Equal(t, []string{"b"}, co.Keys())
+	assert.Equal(t, map[string]int{"b": 2}, co.ToMapCollection().All())
+}
+
+func TestExpiringMapCollection_Pull(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var expired []string
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{
+		Now:      clock.Now,
+		OnExpire: func(k string, _ int) { expired = append(expired, k) },
+	})
+	co.Put("a", 1, time.Second).Put("b", 2, time.Second)
+	v, ok := co.Pull("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, v)
+
+	clock.Advance(time.Second)
+	_, ok = co.Pull("b")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, []string{"b"}, expired)
+	assert.Equal(t, 0, co.Sweep())
+}
+
+func TestExpiringMapCollection_Sweep(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	expired := map[string]int{}
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{
+		Now:      clock.Now,
+		OnExpire: func(k string, v int) { expired[k] = v },
+	})
+	co.Put("a", 1, time.Second).Put("b", 2, time.Second).Put("c", 3, time.Hour)
+	assert.Equal(t, 0, co.Sweep())
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, 2, co.Sweep())
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, expired)
+}
+
+func TestExpiringMapCollection_Janitor(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	done := make(chan string, 1)
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{
+		Now:      clock.Now,
+		OnExpire: func(k string, _ int) { done <- k },
+	})
+	co.Put("a", 1, time.Second)
+	clock.Advance(time.Second)
+
+	co.StartJanitor(time.Millisecond)
+	defer co.StopJanitor()
+	select {
+	case k := <-done:
+		assert.Equal(t, "a", k)
+	case <-time.After(time.Second):
+		t.Fatal("janitor did not sweep")
+	}
+}
+
+func TestExpiringMapCollection_RestartJanitor(t *testing.T) {
+	co := NewExpiringMapCollection(ExpiringOptions[string, int]{})
+	assert.Panics(t, func() { co.StartJanitor(0) })
+
+	before := runtime.NumGoroutine()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			co.StartJanitor(time.Millisecond)
+		}()
+	}
+	wg.Wait()
+	co.StopJanitor()
+
+	// stopped janitors exit asynchronously.
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
@@ -0,0 +1,193 @@
+package maps
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Flatten turns nested maps with string keys and slices into a single level map whose
+// keys are the joined paths, e.g. {"a": {"items": [{"name": "x"}]}} becomes {"a.items.0.name": "x"}.
+// Key segments containing sep or the escape rune are escaped, the escape rune defaults to '\'
+// and passing 0 disables escaping. An empty sep means ".".
+func Flatten(co *MapCollection[string, any], sep string, escape ...rune) *MapCollection[string, any] {
+	f := newFlattener(sep, escape)
+	ret := NewMapCollection(map[string]any{})
+	for k, v := range co.items {
+		f.flatten(ret.items, f.escape(k), reflect.ValueOf(v))
+	}
+	return ret
+}
+
+// Unflatten is the inverse of Flatten. Nodes whose keys are exactly 0..n-1 become []any.
+func Unflatten(co *MapCollection[string, any], sep string, escape ...rune) (*MapCollection[string, any], error) {
+	f := newFlattener(sep, escape)
+	root := map[string]any{}
+	created := map[uintptr]bool{}
+
+	keys := co.Keys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		segments := f.split(key)
+		node := root
+		for i, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment]
+			if !ok {
+				nested := map[string]any{}
+				created[reflect.ValueOf(nested).Pointer()] = true
+				child, node[segment] = nested, nested
+			}
+			// only descend into nodes created here, a map stored as a value belongs to the caller.
+			next, ok := child.(map[string]any)
+			if !ok || !created[reflect.ValueOf(next).Pointer()] {
+				return nil, fmt.Errorf("maps: key %q conflicts with value at %q", key, f.join(segments[:i+1]))
+			}
+			node = next
+		}
+
+		last := segments[len(segments)-1]
+		if _, ok := node[last]; ok {
+			return nil, fmt.Errorf("maps: key %q conflicts with nested keys", key)
+		}
+		node[last] = co.items[key]
+	}
+	for k, v := range root {
+		root[k] = listify(v, created)
+	}
+	return NewMapCollection(root), nil
+}
+
+type flattener struct {
+	sep string
+	esc rune
+}
+
+func newFlattener(sep string, escape []rune) *flattener {
+	if sep == "" {
+		sep = "."
+	}
+	f := &flattener{sep: sep, esc: '\\'}
+	if len(escape) > 0 {
+		f.esc = escape[0]
+	}
+	return f
+}
+
+func (f *flattener) flatten(ret map[string]any, prefix string, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || v.Len() == 0 {
+			break
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			f.flatten(ret, prefix+f.sep+f.escape(iter.Key().String()), iter.Value())
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 || v.Len() == 0 {
+			break
+		}
+		for i := 0; i < v.Len(); i++ {
+			f.flatten(ret, prefix+f.sep+strconv.Itoa(i), v.Index(i))
+		}
+		return
+	case reflect.Interface:
+		if !v.IsNil() {
+			f.flatten(ret, prefix, v.Elem())
+			return
+		}
+	}
+
+	if v.IsValid() {
+		ret[prefix] = v.Interface()
+	} else {
+		ret[prefix] = nil
+	}
+}
+
+func (f *flattener) escape(segment string) string {
+	if f.esc == 0 {
+		return segment
+	}
+	var sb strings.Builder
+	for i := 0; i < len(segment); {
+		if strings.HasPrefix(segment[i:], f.sep) {
+			sb.WriteRune(f.esc)
+			sb.WriteString(f.sep)
+			i += len(f.sep)
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(segment[i:])
+		if r == f.esc {
+			sb.WriteRune(f.esc)
+		}
+		sb.WriteRune(r)
+		i += size
+	}
+	return sb.String()
+}
+
+func (f *flattener) split(key string) []string {
+	var segments []string
+	var sb strings.Builder
+	for i := 0; i < len(key); {
+		r, size := utf8.DecodeRuneInString(key[i:])
+		if f.esc != 0 && r == f.esc && i+size < len(key) {
+			i += size
+			if strings.HasPrefix(key[i:], f.sep) {
+				sb.WriteString(f.sep)
+				i += len(f.sep)
+				continue
+			}
+			r, size = utf8.DecodeRuneInString(key[i:])
+			sb.WriteRune(r)
+			i += size
+			continue
+		}
+		if strings.HasPrefix(key[i:], f.sep) {
+			segments = append(segments, sb.String())
+			sb.Reset()
+			i += len(f.sep)
+			continue
+		}
+		sb.WriteRune(r)
+		i += size
+	}
+	return append(segments, sb.String())
+}
+
+func (f *flattener) join(segments []string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = f.escape(s)
+	}
+	return strings.Join(escaped, f.sep)
+}
+
+// listify converts the nodes created by Unflatten back into slices, user values are left untouched.
+func listify(v any, created map[uintptr]bool) any {
+	m, ok := v.(map[string]any)
+	if !ok || !created[reflect.ValueOf(m).Pointer()] {
+		return v
+	}
+	for k, child := range m {
+		m[k] = listify(child, created)
+	}
+
+	list := make([]any, len(m))
+	for k, child := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+		list[i] = child
+	}
+	if len(list) == 0 {
+		return m
+	}
+	return list
+}
@@ -0,0 +1,84 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	expected := map[string]any{
+		"a.b":            1,
+		"a.items.0.name": "x",
+		"a.items.1":      "y",
+		"c":              true,
+		"e":              []any{},
+	}
+	actual := Flatten(NewMapCollection(map[string]any{
+		"a": map[string]any{
+			"b":     1,
+			"items": []any{map[string]any{"name": "x"}, "y"},
+		},
+		"c": true,
+		"e": []any{},
+	}), ".").All()
+	assert.Equal(t, expected, actual)
+
+	expected = map[string]any{"A__B": 1, "A__C__0": 2}
+	actual = Flatten(NewMapCollection(map[string]any{"A": map[string]any{"B": 1, "C": []int{2}}}), "__").All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenEscape(t *testing.T) {
+	expected := map[string]any{`example\.com.port`: 80, `back\\slash`: 1}
+	actual := Flatten(NewMapCollection(map[string]any{
+		"example.com": map[string]any{"port": 80},
+		`back\slash`:  1,
+	}), ".").All()
+	assert.Equal(t, expected, actual)
+
+	expected = map[string]any{"example.com.port": 80}
+	actual = Flatten(NewMapCollection(map[string]any{"example.com": map[string]any{"port": 80}}), ".", 0).All()
+	assert.Equal(t, expected, actual)
+
+	expected = map[string]any{"a/b!/c": 1}
+	actual = Flatten(NewMapCollection(map[string]any{"a": map[string]any{"b/c": 1}}), "/", '!').All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnflatten(t *testing.T) {
+	expected := map[string]any{
+		"a": map[string]any{
+			"b":     1,
+			"items": []any{map[string]any{"name": "x"}, "y"},
+		},
+		"example.com": map[string]any{"port": 80},
+		"c":           true,
+	}
+	actual, err := Unflatten(NewMapCollection(map[string]any{
+		"a.b":               1,
+		"a.items.0.name":    "x",
+		"a.items.1":         "y",
+		`example\.com.port`: 80,
+		"c":                 true,
+	}), ".")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+
+	roundTrip, err := Unflatten(Flatten(NewMapCollection(expected), "_"), "_")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, roundTrip.All())
+
+	expected = map[string]any{"list": map[string]any{"0": 1, "2": 2}}
+	actual, err = Unflatten(NewMapCollection(map[string]any{"list.0": 1, "list.2": 2}), ".")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+
+	_, err = Unflatten(NewMapCollection(map[string]any{"a": 1, "a.b": 2}), ".")
+	assert.NotNil(t, err)
+
+	userMap := map[string]any{"z": 1}
+	_, err = Unflatten(NewMapCollection(map[string]any{"x": userMap, "x.y": 2}), ".")
+	assert.NotNil(t, err)
+	assert.Equal(t, map[string]any{"z": 1}, userMap)
+}
@@ -42,7 +42,7 @@ func (co *MapCollection[K, V]) Entries() []go_collection.Entry[K, V] {
 	ret := make([]go_collection.Entry[K, V], 0, co.Count())
 
 	for k, v := range co.items {
-		ret = append(ret, go_collection.Entry[K, V]{k, v})
+		ret = append(ret, go_collection.Entry[K, V]{Key: k, Value: v})
 	}
 
 	return ret
@@ -116,3 +116,86 @@ func (co *MapCollection[K, V]) Diff(items map[K]V) *MapCollection[K, V] {
 func (co *MapCollection[K, V]) SymmetricDiff(items map[K]V) *MapCollection[K, V] {
 	return co.Diff(items).Union(NewMapCollection(items).Diff(co.items).All())
 }
+
+func (co *MapCollection[K, V]) Filter(fn func(V, K) bool) *MapCollection[K, V] {
+	ret := NewMapCollection(map[K]V{})
+	for k, v := range co.items {
+		if fn(v, k) {
+			ret.items[k] = v
+		}
+	}
+	return ret
+}
+
+func (co *MapCollection[K, V]) Reject(fn func(V, K) bool) *MapCollection[K, V] {
+	return co.Filter(func(v V, k K) bool { return !fn(v, k) })
+}
+
+func (co *MapCollection[K, V]) Each(fn func(V, K) bool) *MapCollection[K, V] {
+	for k, v := range co.items {
+		if !fn(v, k) {
+			break
+		}
+	}
+	return co
+}
+
+func (co *MapCollection[K, V]) MapValues(fn func(V, K) V) *MapCollection[K, V] {
+	ret := make(map[K]V, co.Count())
+	for k, v := range co.items {
+		ret[k] = fn(v, k)
+	}
+	return NewMapCollection(ret)
+}
+
+func (co *MapCollection[K, V]) Find(fn func(V, K) bool) (key K, value V, _ bool) {
+	for k, v := range co.items {
+		if fn(v, k) {
+			return k, v, true
+		}
+	}
+	return key, value, false
+}
+
+func (co *MapCollection[K, V]) Every(fn func(V, K) bool) bool {
+	_, _, found := co.Find(func(v V, k K) bool { return !fn(v, k) })
+	return !found
+}
+
+func (co *MapCollection[K, V]) Some(fn func(V, K) bool) bool {
+	_, _, found := co.Find(fn)
+	return found
+}
+
+func (co *MapCollection[K, V]) Partition(fn func(V, K) bool) (*MapCollection[K, V], *MapCollection[K, V]) {
+	matched, rest := NewMapCollection(map[K]V{}), NewMapCollection(map[K]V{})
+	for k, v := range co.items {
+		if fn(v, k) {
+			matched.items[k] = v
+		} else {
+			rest.items[k] = v
+		}
+	}
+	return matched, rest
+}
+
+// 1.18 not allow type parameters in methods, nor constraints tighter than the collection's own.
+// So helpers across this package that change the key, return an arbitrary type or need
+// Ordered or string keys live at the package level instead of being MapCollection methods.
+// https://github.com/golang/go/issues/49085
+
+// MapKeys re-keys the items, when fn maps several keys to the same new key the last one wins.
+func MapKeys[K, R comparable, V any](items map[K]V, fn func(V, K) R) *MapCollection[R, V] {
+	ret := make(map[R]V, len(items))
+	for k, v := range items {
+		ret[fn(v, k)] = v
+	}
+	return NewMapCollection(ret)
+}
+
+func Reduce[K comparable, V, R any](items map[K]V, fn func(V, R, K) R, init R) R {
+	for k, v := range items {
+		init = fn(v, init, k)
+	}
+	return init
+}
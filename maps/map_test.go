@@ -41,14 +41,14 @@ func TestMapCollection_Values(t *testing.T) {
 }
 
 func TestMapCollection_Entries(t *testing.T) {
-	expected := []go_collection.Entry[string, int]{{"a", 1}, {"b", 2}}
+	expected := []go_collection.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}
 	actual := NewMapCollection(map[string]int{"a": 1, "b": 2}).Entries()
 	assert.ElementsMatch(t, expected, actual)
 }
 
 func TestMapCollection_FromEntries(t *testing.T) {
 	expected := map[string]int{"a": 1, "b": 2}
-	actual := NewMapCollection(map[string]int{}).FromEntries([]go_collection.Entry[string, int]{{"a", 1}, {"b", 2}}).All()
+	actual := NewMapCollection(map[string]int{}).FromEntries([]go_collection.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}}).All()
 	assert.Equal(t, expected, actual)
 }
 
@@ -118,3 +118,69 @@ func TestMapCollection_SymmetricDiff(t *testing.T) {
 	actual := NewMapCollection(map[string]int{"a": 1, "b": 2}).SymmetricDiff(map[string]int{"a": 1, "c": 3}).All()
 	assert.Equal(t, expected, actual)
 }
+
+func TestMapCollection_Filter(t *testing.T) {
+	expected := map[string]int{"b": 2, "c": 3}
+	actual := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Filter(func(v int, _ string) bool { return v > 1 }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestMapCollection_Reject(t *testing.T) {
+	expected := map[string]int{"a": 1, "c": 3}
+	actual := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Reject(func(_ int, k string) bool { return k == "b" }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestMapCollection_Each(t *testing.T) {
+	expected := 6
+	actual := 0
+	NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Each(func(v int, _ string) bool { actual += v; return true })
+	assert.Equal(t, expected, actual)
+
+	calls := 0
+	NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Each(func(int, string) bool { calls++; return false })
+	assert.Equal(t, 1, calls)
+}
+
+func TestMapCollection_MapValues(t *testing.T) {
+	expected := map[string]string{"a": "a1", "b": "b2"}
+	actual := NewMapCollection(map[string]string{"a": "1", "b": "2"}).MapValues(func(v string, k string) string { return k + v }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestMapCollection_Find(t *testing.T) {
+	k, v, ok := NewMapCollection(map[string]int{"a": 1, "b": 2}).Find(func(v int, _ string) bool { return v == 2 })
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "b", k)
+	assert.Equal(t, 2, v)
+
+	_, _, ok = NewMapCollection(map[string]int{"a": 1}).Find(func(v int, _ string) bool { return v == 2 })
+	assert.Equal(t, false, ok)
+}
+
+func TestMapCollection_EveryAndSome(t *testing.T) {
+	data := NewMapCollection(map[string]int{"a": 1, "b": 2})
+	assert.Equal(t, true, data.Every(func(v int, _ string) bool { return v > 0 }))
+	assert.Equal(t, false, data.Every(func(v int, _ string) bool { return v > 1 }))
+	assert.Equal(t, true, data.Some(func(v int, _ string) bool { return v > 1 }))
+	assert.Equal(t, false, data.Some(func(v int, _ string) bool { return v > 2 }))
+	assert.Equal(t, true, NewMapCollection(map[string]int{}).Every(func(int, string) bool { return false }))
+}
+
+func TestMapCollection_Partition(t *testing.T) {
+	matched, rest := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).Partition(func(v int, _ string) bool { return v%2 == 1 })
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, matched.All())
+	assert.Equal(t, map[string]int{"b": 2}, rest.All())
+}
+
+func TestMapKeys(t *testing.T) {
+	expected := map[int]string{1: "a", 2: "bb"}
+	actual := MapKeys(map[string]string{"a": "a", "bb": "bb"}, func(_ string, k string) int { return len(k) }).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestReduce(t *testing.T) {
+	expected := 6
+	actual := Reduce(map[string]int{"a": 1, "b": 2, "c": 3}, func(v int, sum int, _ string) int { return sum + v }, 0)
+	assert.Equal(t, expected, actual)
+}
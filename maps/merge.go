@@ -0,0 +1,192 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type MergeStrategy int
+
+const (
+	// MergeOverride replaces existing values with incoming ones.
+	MergeOverride MergeStrategy = iota
+	// MergeKeepExisting keeps existing values and only adds missing keys.
+	MergeKeepExisting
+	// MergeAppendSlices appends incoming slices to existing slices of the same type
+	// and overrides any other value.
+	MergeAppendSlices
+	// MergeCustom asks a MergeResolver to decide every conflict.
+	MergeCustom
+)
+
+// MergeResolver decides the value stored at path when existing and incoming
+// cannot be merged recursively.
+type MergeResolver func(path []any, existing, incoming any) (any, error)
+
+var ErrMergeCycle = errors.New("maps: cycle detected during deep merge")
+
+// DeepMerge merges items into the collection in place. Nested maps of the same type
+// are merged recursively, other values are resolved by the strategy. Values copied
+// from items are deep cloned, so later merges never write into items. The collection's
+// maps and slices are cloned as well and only replaced once the whole merge succeeded,
+// so on error the collection is left unchanged.
+func (co *MapCollection[K, V]) DeepMerge(items map[K]V, strategy MergeStrategy, resolver ...MergeResolver) (*MapCollection[K, V], error) {
+	m := &merger{strategy: strategy, visiting: map[uintptr]bool{}}
+	if len(resolver) > 0 {
+		m.resolver = resolver[0]
+	}
+	if strategy == MergeCustom && m.resolver == nil {
+		return co, errors.New("maps: MergeCustom requires a resolver")
+	}
+
+	// merge into a deep clone and swap it in, so a failure leaves the collection untouched.
+	cloned, err := m.clone(nil, co.items)
+	if err != nil {
+		return co, err
+	}
+	merged, _ := cloned.(map[K]V)
+	if merged == nil {
+		merged = map[K]V{}
+	}
+	if _, err := m.merge(nil, merged, items); err != nil {
+		return co, err
+	}
+	co.items = merged
+	return co, nil
+}
+
+type merger struct {
+	strategy MergeStrategy
+	resolver MergeResolver
+	visiting map[uintptr]bool
+}
+
+func (m *merger) merge(path []any, existing, incoming any) (any, error) {
+	ev, iv := reflect.ValueOf(existing), reflect.ValueOf(incoming)
+	if ev.Kind() != reflect.Map || iv.Kind() != reflect.Map || ev.Type() != iv.Type() || ev.IsNil() {
+		return m.resolve(path, existing, incoming)
+	}
+
+	if err := m.enter(path, iv); err != nil {
+		return nil, err
+	}
+	defer m.leave(iv)
+
+	elem := ev.Type().Elem()
+	iter := iv.MapRange()
+	for iter.Next() {
+		key := iter.Key()
+		p := append(path[:len(path):len(path)], key.Interface())
+
+		var merged any
+		var err error
+		if cur := ev.MapIndex(key); cur.IsValid() {
+			merged, err = m.merge(p, cur.Interface(), iter.Value().Interface())
+		} else {
+			merged, err = m.clone(p, iter.Value().Interface())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		mv := reflect.ValueOf(merged)
+		if !mv.IsValid() {
+			mv = reflect.Zero(elem)
+		}
+		if !mv.Type().AssignableTo(elem) {
+			return nil, fmt.Errorf("maps: cannot store %s in %s at %v", mv.Type(), elem, p)
+		}
+		ev.SetMapIndex(key, mv)
+	}
+	return existing, nil
+}
+
+func (m *merger) resolve(path []any, existing, incoming any) (any, error) {
+	switch m.strategy {
+	case MergeKeepExisting:
+		return existing, nil
+	case MergeAppendSlices:
+		ev, iv := reflect.ValueOf(existing), reflect.ValueOf(incoming)
+		if ev.Kind() == reflect.Slice && iv.Kind() == reflect.Slice && ev.Type() == iv.Type() {
+			cloned, err := m.clone(path, incoming)
+			if err != nil {
+				return nil, err
+			}
+			ret := reflect.MakeSlice(ev.Type(), 0, ev.Len()+iv.Len())
+			return reflect.AppendSlice(reflect.AppendSlice(ret, ev), reflect.ValueOf(cloned)).Interface(), nil
+		}
+	case MergeCustom:
+		return m.resolver(path, existing, incoming)
+	}
+	return m.clone(path, incoming)
+}
+
+func (m *merger) clone(path []any, v any) (any, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return v, nil
+		}
+		if err := m.enter(path, rv); err != nil {
+			return nil, err
+		}
+		defer m.leave(rv)
+
+		ret := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			cloned, err := m.clone(append(path[:len(path):len(path)], iter.Key().Interface()), iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			ret.SetMapIndex(iter.Key(), valueOf(cloned, rv.Type().Elem()))
+		}
+		return ret.Interface(), nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v, nil
+		}
+		if err := m.enter(path, rv); err != nil {
+			return nil, err
+		}
+		defer m.leave(rv)
+
+		ret := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			cloned, err := m.clone(append(path[:len(path):len(path)], i), rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			ret.Index(i).Set(valueOf(cloned, rv.Type().Elem()))
+		}
+		return ret.Interface(), nil
+	}
+	return v, nil
+}
+
+func (m *merger) enter(path []any, v reflect.Value) error {
+	if v.Kind() == reflect.Slice && v.Len() == 0 {
+		return nil
+	}
+	if m.visiting[v.Pointer()] {
+		return fmt.Errorf("%w at %v", ErrMergeCycle, path)
+	}
+	m.visiting[v.Pointer()] = true
+	return nil
+}
+
+func (m *merger) leave(v reflect.Value) {
+	if v.Kind() == reflect.Slice && v.Len() == 0 {
+		return
+	}
+	delete(m.visiting, v.Pointer())
+}
+
+func valueOf(v any, typ reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(typ)
+	}
+	return reflect.ValueOf(v)
+}
@@ -0,0 +1,110 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapCollection_DeepMerge(t *testing.T) {
+	base := func() map[string]any {
+		return map[string]any{
+			"name": "app",
+			"db":   map[string]any{"host": "localhost", "port": 5432},
+			"tags": []any{"a"},
+		}
+	}
+	layer := map[string]any{
+		"db":    map[string]any{"port": 6432, "user": "root"},
+		"tags":  []any{"b"},
+		"debug": true,
+	}
+
+	expected := map[string]any{
+		"name":  "app",
+		"db":    map[string]any{"host": "localhost", "port": 6432, "user": "root"},
+		"tags":  []any{"b"},
+		"debug": true,
+	}
+	actual, err := NewMapCollection(base()).DeepMerge(layer, MergeOverride)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+
+	expected = map[string]any{
+		"name":  "app",
+		"db":    map[string]any{"host": "localhost", "port": 5432, "user": "root"},
+		"tags":  []any{"a"},
+		"debug": true,
+	}
+	actual, err = NewMapCollection(base()).DeepMerge(layer, MergeKeepExisting)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+
+	actual, err = NewMapCollection(base()).DeepMerge(layer, MergeAppendSlices)
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"a", "b"}, actual.All()["tags"])
+	assert.Equal(t, 6432, actual.All()["db"].(map[string]any)["port"])
+
+	actual, err = NewMapCollection(base()).DeepMerge(layer, MergeCustom, func(path []any, existing, incoming any) (any, error) {
+		if e, ok := existing.(int); ok {
+			return e + incoming.(int), nil
+		}
+		return incoming, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 5432+6432, actual.All()["db"].(map[string]any)["port"])
+
+	_, err = NewMapCollection(base()).DeepMerge(layer, MergeCustom)
+	assert.NotNil(t, err)
+}
+
+func TestMapCollection_DeepMergeClonesIncoming(t *testing.T) {
+	nested := map[string]any{"a": 1}
+	co, err := NewMapCollection(map[string]any{}).DeepMerge(map[string]any{"n": nested}, MergeOverride)
+	assert.Nil(t, err)
+	_, err = co.DeepMerge(map[string]any{"n": map[string]any{"b": 2}}, MergeOverride)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"a": 1}, nested)
+	assert.Equal(t, map[string]any{"a": 1, "b": 2}, co.All()["n"])
+}
+
+func TestMapCollection_DeepMergeTyped(t *testing.T) {
+	expected := map[string]map[string]int{"a": {"x": 1, "y": 2}, "b": {"z": 3}}
+	actual, err := NewMapCollection(map[string]map[string]int{"a": {"x": 1}}).
+		DeepMerge(map[string]map[string]int{"a": {"y": 2}, "b": {"z": 3}}, MergeOverride)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual.All())
+}
+
+func TestMapCollection_DeepMergeCycle(t *testing.T) {
+	cyclic := map[string]any{}
+	cyclic["self"] = cyclic
+	_, err := NewMapCollection(map[string]any{}).DeepMerge(map[string]any{"c": cyclic}, MergeOverride)
+	assert.True(t, errors.Is(err, ErrMergeCycle))
+
+	list := []any{nil}
+	list[0] = list
+	_, err = NewMapCollection(map[string]any{}).DeepMerge(map[string]any{"l": list}, MergeOverride)
+	assert.True(t, errors.Is(err, ErrMergeCycle))
+}
+
+func TestMapCollection_DeepMergeFailureLeavesCollection(t *testing.T) {
+	cyclic := map[string]any{}
+	cyclic["self"] = cyclic
+	co := NewMapCollection(map[string]any{"db": map[string]any{"port": 5432}})
+	_, err := co.DeepMerge(map[string]any{
+		"db":    map[string]any{"port": 6432, "user": "root"},
+		"added": 1,
+		"c":     cyclic,
+	}, MergeOverride)
+	assert.True(t, errors.Is(err, ErrMergeCycle))
+	assert.Equal(t, map[string]any{"db": map[string]any{"port": 5432}}, co.All())
+
+	_, err = co.DeepMerge(map[string]any{"db": map[string]any{"port": 1, "user": "root"}}, MergeCustom,
+		func(path []any, existing, incoming any) (any, error) {
+			return nil, errors.New("conflict")
+		})
+	assert.NotNil(t, err)
+	assert.Equal(t, map[string]any{"db": map[string]any{"port": 5432}}, co.All())
+}
@@ -0,0 +1,152 @@
+package maps
+
+import (
+	"github.com/google/go-cmp/cmp"
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// MultiMapCollection maps a key to many values. The list-valued variant keeps every value
+// in insertion order, the set-valued variant drops values equal (by cmp.Equal) to one already stored.
+// The set-valued check scans the key's values, so every Put costs O(n) in the number of
+// values of the key and building a key of n values costs O(n²). Prefer a MapCollection of
+// sets for keys with many comparable values.
+type MultiMapCollection[K comparable, V any] struct {
+	items map[K][]V
+	set   bool
+}
+
+func NewMultiMapCollection[K comparable, V any](v map[K][]V) *MultiMapCollection[K, V] {
+	return newMultiMapCollection(v, false)
+}
+
+func NewSetMultiMapCollection[K comparable, V any](v map[K][]V) *MultiMapCollection[K, V] {
+	return newMultiMapCollection(v, true)
+}
+
+// MultiMapFromGroupBy converts the result of GroupBy into a list-valued MultiMapCollection.
+func MultiMapFromGroupBy[K comparable, V any](groups *MapCollection[K, *slices.SliceCollection[V]]) *MultiMapCollection[K, V] {
+	ret := NewMultiMapCollection[K, V](nil)
+	for k, v := range groups.items {
+		ret.Put(k, v.All()...)
+	}
+	return ret
+}
+
+func newMultiMapCollection[K comparable, V any](v map[K][]V, set bool) *MultiMapCollection[K, V] {
+	co := &MultiMapCollection[K, V]{items: map[K][]V{}, set: set}
+	for k, values := range v {
+		co.Put(k, values...)
+	}
+	return co
+}
+
+func (co *MultiMapCollection[K, V]) All() map[K][]V {
+	return co.items
+}
+
+func (co *MultiMapCollection[K, V]) KeyCount() int {
+	return len(co.items)
+}
+
+func (co *MultiMapCollection[K, V]) Count() (n int) {
+	for _, values := range co.items {
+		n += len(values)
+	}
+	return n
+}
+
+func (co *MultiMapCollection[K, V]) CountOf(key K) int {
+	return len(co.items[key])
+}
+
+func (co *MultiMapCollection[K, V]) Empty() bool {
+	return co.KeyCount() == 0
+}
+
+func (co *MultiMapCollection[K, V]) Keys() (keys []K) {
+	for k := range co.items {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (co *MultiMapCollection[K, V]) Values() (values []V) {
+	for _, v := range co.items {
+		values = append(values, v...)
+	}
+	return values
+}
+
+func (co *MultiMapCollection[K, V]) Entries() []go_collection.Entry[K, V] {
+	ret := make([]go_collection.Entry[K, V], 0, co.Count())
+	for k, values := range co.items {
+		for _, v := range values {
+			ret = append(ret, go_collection.Entry[K, V]{Key: k, Value: v})
+		}
+	}
+	return ret
+}
+
+func (co *MultiMapCollection[K, V]) Has(key K) bool {
+	_, ok := co.items[key]
+	return ok
+}
+
+func (co *MultiMapCollection[K, V]) HasValue(key K, value V) bool {
+	return co.indexOf(key, value) != -1
+}
+
+func (co *MultiMapCollection[K, V]) Get(key K) []V {
+	ret := make([]V, len(co.items[key]))
+	copy(ret, co.items[key])
+	return ret
+}
+
+func (co *MultiMapCollection[K, V]) Put(key K, values ...V) *MultiMapCollection[K, V] {
+	for _, v := range values {
+		if co.set && co.HasValue(key, v) {
+			continue
+		}
+		co.items[key] = append(co.items[key], v)
+	}
+	return co
+}
+
+// RemoveValue removes the first value equal to value, the key is dropped once it has no values.
+func (co *MultiMapCollection[K, V]) RemoveValue(key K, value V) bool {
+	i := co.indexOf(key, value)
+	if i == -1 {
+		return false
+	}
+	values := co.items[key]
+	if len(values) == 1 {
+		delete(co.items, key)
+		return true
+	}
+	co.items[key] = append(values[:i:i], values[i+1:]...)
+	return true
+}
+
+func (co *MultiMapCollection[K, V]) Pull(key K) ([]V, bool) {
+	values, ok := co.items[key]
+	delete(co.items, key)
+	return values, ok
+}
+
+func (co *MultiMapCollection[K, V]) ToMapCollection() *MapCollection[K, []V] {
+	ret := make(map[K][]V, len(co.items))
+	for k := range co.items {
+		ret[k] = co.Get(k)
+	}
+	return NewMapCollection(ret)
+}
+
+func (co *MultiMapCollection[K, V]) indexOf(key K, value V) int {
+	for i, v := range co.items[key] {
+		if cmp.Equal(v, value) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,76 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestNewMultiMapCollection(t *testing.T) {
+	expected := map[string][]int{"a": {1, 1, 2}}
+	actual := NewMultiMapCollection(map[string][]int{"a": {1, 1, 2}, "b": {}}).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestNewSetMultiMapCollection(t *testing.T) {
+	expected := map[string][]int{"a": {1, 2}}
+	actual := NewSetMultiMapCollection(map[string][]int{"a": {1, 1, 2}}).Put("a", 2, 3).All()
+	assert.Equal(t, map[string][]int{"a": {1, 2, 3}}, actual)
+	assert.Equal(t, expected, NewSetMultiMapCollection(map[string][]int{"a": {1, 2, 1}}).All())
+}
+
+func TestMultiMapFromGroupBy(t *testing.T) {
+	expected := map[bool][]int{true: {0, 2}, false: {1, 3}}
+	groups := GroupBy(slices.NewSliceCollection([]int{0, 1, 2, 3}), func(v int, _ int) bool { return v%2 == 0 })
+	actual := MultiMapFromGroupBy(groups).All()
+	assert.Equal(t, expected, actual)
+}
+
+func TestMultiMapCollection_PutAndGet(t *testing.T) {
+	data := NewMultiMapCollection[string, int](nil).Put("a", 1).Put("a", 2, 1).Put("b", 3)
+	assert.Equal(t, []int{1, 2, 1}, data.Get("a"))
+	assert.Equal(t, []int{}, data.Get("z"))
+	assert.Equal(t, true, data.Has("b"))
+	assert.Equal(t, true, data.HasValue("a", 2))
+	assert.Equal(t, false, data.HasValue("b", 2))
+}
+
+func TestMultiMapCollection_Counts(t *testing.T) {
+	data := NewMultiMapCollection(map[string][]int{"a": {1, 2}, "b": {3}})
+	assert.Equal(t, 2, data.KeyCount())
+	assert.Equal(t, 3, data.Count())
+	assert.Equal(t, 2, data.CountOf("a"))
+	assert.Equal(t, 0, data.CountOf("z"))
+	assert.Equal(t, false, data.Empty())
+	assert.ElementsMatch(t, []string{"a", "b"}, data.Keys())
+	assert.ElementsMatch(t, []int{1, 2, 3}, data.Values())
+	assert.ElementsMatch(t, []go_collection.Entry[string, int]{{Key: "a", Value: 1}, {Key: "a", Value: 2}, {Key: "b", Value: 3}}, data.Entries())
+}
+
+func TestMultiMapCollection_RemoveValue(t *testing.T) {
+	data := NewMultiMapCollection(map[string][]int{"a": {1, 2, 1}, "b": {3}})
+	assert.Equal(t, true, data.RemoveValue("a", 1))
+	assert.Equal(t, []int{2, 1}, data.Get("a"))
+	assert.Equal(t, false, data.RemoveValue("a", 5))
+	assert.Equal(t, true, data.RemoveValue("b", 3))
+	assert.Equal(t, false, data.Has("b"))
+}
+
+func TestMultiMapCollection_Pull(t *testing.T) {
+	data := NewMultiMapCollection(map[string][]int{"a": {1, 2}})
+	values, ok := data.Pull("a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []int{1, 2}, values)
+	assert.Equal(t, true, data.Empty())
+}
+
+func TestMultiMapCollection_ToMapCollection(t *testing.T) {
+	data := NewMultiMapCollection(map[string][]int{"a": {1, 2}})
+	actual := data.ToMapCollection()
+	assert.Equal(t, map[string][]int{"a": {1, 2}}, actual.All())
+
+	actual.All()["a"][0] = 100
+	assert.Equal(t, []int{1, 2}, data.Get("a"))
+}
@@ -0,0 +1,47 @@
+package maps
+
+import (
+	"sort"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+)
+
+func (co *MapCollection[K, V]) SortedKeysFunc(less func(a, b K) bool) []K {
+	keys := co.Keys()
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
+
+func (co *MapCollection[K, V]) EntriesSortedFunc(less func(a, b go_collection.Entry[K, V]) bool) []go_collection.Entry[K, V] {
+	entries := co.Entries()
+	sort.SliceStable(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+	return entries
+}
+
+func SortedKeys[K go_collection.Ordered, V any](co *MapCollection[K, V]) []K {
+	return co.SortedKeysFunc(func(a, b K) bool { return a < b })
+}
+
+func EntriesSortedByKey[K go_collection.Ordered, V any](co *MapCollection[K, V]) []go_collection.Entry[K, V] {
+	return co.EntriesSortedFunc(func(a, b go_collection.Entry[K, V]) bool { return a.Key < b.Key })
+}
+
+// EntriesSortedByValue sorts entries by value, entries with equal values are ordered by key.
+func EntriesSortedByValue[K, V go_collection.Ordered](co *MapCollection[K, V]) []go_collection.Entry[K, V] {
+	return co.EntriesSortedFunc(func(a, b go_collection.Entry[K, V]) bool {
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.Key < b.Key
+	})
+}
+
+// EachSorted is Each in ascending key order.
+func EachSorted[K go_collection.Ordered, V any](co *MapCollection[K, V], fn func(V, K) bool) *MapCollection[K, V] {
+	for _, k := range SortedKeys(co) {
+		if !fn(co.items[k], k) {
+			break
+		}
+	}
+	return co
+}
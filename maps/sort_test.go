@@ -0,0 +1,51 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	go_collection "github.com/wwaayyaa/go-collection"
+)
+
+func TestMapCollection_SortedKeysFunc(t *testing.T) {
+	expected := []string{"c", "b", "a"}
+	actual := NewMapCollection(map[string]int{"a": 1, "b": 2, "c": 3}).SortedKeysFunc(func(a, b string) bool { return a > b })
+	assert.Equal(t, expected, actual)
+}
+
+func TestMapCollection_EntriesSortedFunc(t *testing.T) {
+	expected := []go_collection.Entry[string, string]{{Key: "x", Value: "a"}, {Key: "y", Value: "B"}, {Key: "z", Value: "c"}}
+	actual := NewMapCollection(map[string]string{"z": "c", "x": "a", "y": "B"}).
+		EntriesSortedFunc(func(a, b go_collection.Entry[string, string]) bool {
+			return strings.ToLower(a.Value) < strings.ToLower(b.Value)
+		})
+	assert.Equal(t, expected, actual)
+}
+
+func TestSortedKeys(t *testing.T) {
+	expected := []int{1, 2, 10}
+	actual := SortedKeys(NewMapCollection(map[int]string{10: "a", 2: "b", 1: "c"}))
+	assert.Equal(t, expected, actual)
+}
+
+func TestEntriesSortedByKey(t *testing.T) {
+	expected := []go_collection.Entry[string, int]{{Key: "a", Value: 3}, {Key: "b", Value: 1}}
+	actual := EntriesSortedByKey(NewMapCollection(map[string]int{"b": 1, "a": 3}))
+	assert.Equal(t, expected, actual)
+}
+
+func TestEntriesSortedByValue(t *testing.T) {
+	expected := []go_collection.Entry[string, int]{{Key: "b", Value: 1}, {Key: "c", Value: 1}, {Key: "a", Value: 3}}
+	actual := EntriesSortedByValue(NewMapCollection(map[string]int{"c": 1, "a": 3, "b": 1}))
+	assert.Equal(t, expected, actual)
+}
+
+func TestEachSorted(t *testing.T) {
+	var actual []string
+	EachSorted(NewMapCollection(map[string]int{"c": 3, "a": 1, "b": 2}), func(_ int, k string) bool {
+		actual = append(actual, k)
+		return k != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, actual)
+}
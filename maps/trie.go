@@ -0,0 +1,176 @@
+package maps
+
+import (
+	"sort"
+)
+
+// Trie stores values by string key and answers prefix queries. Keys are split by byte,
+// so prefixes of multi-byte characters also match.
+type Trie[V any] struct {
+	root *trieNode[V]
+	size int
+}
+
+type trieNode[V any] struct {
+	children map[byte]*trieNode[V]
+	value    V
+	leaf     bool
+}
+
+func NewTrie[V any](v map[string]V) *Trie[V] {
+	co := &Trie[V]{root: &trieNode[V]{}}
+	for k, value := range v {
+		co.Put(k, value)
+	}
+	return co
+}
+
+func (co *Trie[V]) Count() int {
+	return co.size
+}
+
+func (co *Trie[V]) Empty() bool {
+	return co.size == 0
+}
+
+func (co *Trie[V]) Put(key string, value V) *Trie[V] {
+	n := co.root
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = map[byte]*trieNode[V]{}
+		}
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = &trieNode[V]{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	if !n.leaf {
+		co.size++
+	}
+	n.value, n.leaf = value, true
+	return co
+}
+
+func (co *Trie[V]) Get(key string) (value V, _ bool) {
+	n := co.find(key)
+	if n == nil || !n.leaf {
+		return value, false
+	}
+	return n.value, true
+}
+
+func (co *Trie[V]) Has(key string) bool {
+	_, ok := co.Get(key)
+	return ok
+}
+
+// Delete removes key and prunes the branches it leaves empty.
+func (co *Trie[V]) Delete(key string) (value V, _ bool) {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	n := co.root
+	path = append(path, n)
+	for i := 0; i < len(key); i++ {
+		if n = n.children[key[i]]; n == nil {
+			return value, false
+		}
+		path = append(path, n)
+	}
+	if !n.leaf {
+		return value, false
+	}
+
+	value = n.value
+	var zero V
+	n.value, n.leaf = zero, false
+	co.size--
+	for i := len(key); i > 0 && !path[i].leaf && len(path[i].children) == 0; i-- {
+		delete(path[i-1].children, key[i-1])
+	}
+	return value, true
+}
+
+func (co *Trie[V]) HasPrefix(prefix string) bool {
+	n := co.find(prefix)
+	return n != nil && (n.leaf || len(n.children) > 0)
+}
+
+// WithPrefix returns every entry whose key starts with prefix.
+func (co *Trie[V]) WithPrefix(prefix string) *MapCollection[string, V] {
+	ret := NewMapCollection(map[string]V{})
+	if n := co.find(prefix); n != nil {
+		n.walk([]byte(prefix), func(key string, v V) bool {
+			ret.items[key] = v
+			return true
+		})
+	}
+	return ret
+}
+
+// LongestPrefix returns the entry with the longest key that is a prefix of s.
+func (co *Trie[V]) LongestPrefix(s string) (key string, value V, _ bool) {
+	found := false
+	n := co.root
+	for i := 0; ; i++ {
+		if n.leaf {
+			key, value, found = s[:i], n.value, true
+		}
+		if i == len(s) {
+			break
+		}
+		if n = n.children[s[i]]; n == nil {
+			break
+		}
+	}
+	return key, value, found
+}
+
+// Keys returns the keys in lexicographic order.
+func (co *Trie[V]) Keys() []string {
+	keys := make([]string, 0, co.size)
+	co.Each(func(_ V, k string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+// Each visits the entries in lexicographic key order until fn returns false.
+func (co *Trie[V]) Each(fn func(V, string) bool) *Trie[V] {
+	co.root.walk(nil, func(k string, v V) bool { return fn(v, k) })
+	return co
+}
+
+func (co *Trie[V]) All() map[string]V {
+	return co.WithPrefix("").All()
+}
+
+func (co *Trie[V]) ToMapCollection() *MapCollection[string, V] {
+	return co.WithPrefix("")
+}
+
+func (co *Trie[V]) find(key string) *trieNode[V] {
+	n := co.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.children[key[i]]
+	}
+	return n
+}
+
+func (n *trieNode[V]) walk(prefix []byte, fn func(string, V) bool) bool {
+	if n.leaf && !fn(string(prefix), n.value) {
+		return false
+	}
+	edges := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		edges = append(edges, b)
+	}
+	sort.Slice(edges, func(i, j int) bool { return edges[i] < edges[j] })
+	for _, b := range edges {
+		if !n.children[b].walk(append(prefix, b), fn) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrie(t *testing.T) {
+	expected := map[string]int{"a": 1, "ab": 2, "b": 3}
+	actual := NewTrie(expected)
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, 3, actual.Count())
+	assert.Equal(t, true, NewTrie[int](nil).Empty())
+}
+
+func TestTrie_PutAndGet(t *testing.T) {
+	data := NewTrie[int](nil).Put("tea", 1).Put("ten", 2).Put("tea", 3).Put("", 0)
+	v, ok := data.Get("tea")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 3, v)
+	_, ok = data.Get("te")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, true, data.Has(""))
+	assert.Equal(t, false, data.Has("tent"))
+	assert.Equal(t, 3, data.Count())
+}
+
+func TestTrie_Delete(t *testing.T) {
+	data := NewTrie(map[string]int{"tea": 1, "team": 2})
+	v, ok := data.Delete("team")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, v)
+	_, ok = data.Delete("te")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, false, data.HasPrefix("team"))
+	assert.Equal(t, true, data.HasPrefix("te"))
+
+	data.Delete("tea")
+	assert.Equal(t, true, data.Empty())
+	assert.Equal(t, false, data.HasPrefix("t"))
+}
+
+func TestTrie_WithPrefix(t *testing.T) {
+	data := NewTrie(map[string]int{"car": 1, "cart": 2, "cat": 3, "dog": 4})
+	assert.Equal(t, map[string]int{"car": 1, "cart": 2}, data.WithPrefix("car").All())
+	assert.Equal(t, map[string]int{"car": 1, "cart": 2, "cat": 3}, data.WithPrefix("ca").All())
+	assert.Equal(t, true, data.WithPrefix("x").Empty())
+}
+
+func TestTrie_LongestPrefix(t *testing.T) {
+	routes := NewTrie(map[string]string{"/": "root", "/api": "api", "/api/v1": "v1"})
+	k, v, ok := routes.LongestPrefix("/api/v1/users")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "/api/v1", k)
+	assert.Equal(t, "v1", v)
+
+	k, _, _ = routes.LongestPrefix("/apx")
+	assert.Equal(t, "/", k)
+
+	_, _, ok = routes.LongestPrefix("api")
+	assert.Equal(t, false, ok)
+}
+
+func TestTrie_KeysAndEach(t *testing.T) {
+	data := NewTrie(map[string]int{"b": 1, "a": 2, "ab": 3, "ba": 4})
+	assert.Equal(t, []string{"a", "ab", "b", "ba"}, data.Keys())
+
+	var visited []string
+	data.Each(func(_ int, k string) bool {
+		visited = append(visited, k)
+		return k != "ab"
+	})
+	assert.Equal(t, []string{"a", "ab"}, visited)
+	assert.Equal(t, 4, data.ToMapCollection().Count())
+}
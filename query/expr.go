@@ -0,0 +1,157 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+type expr interface {
+	eval(current, root any) any
+}
+
+// nodeList is what a path evaluates to inside a filter, an empty list means the path matched nothing.
+type nodeList []any
+
+type pathExpr struct {
+	relative bool
+	segments []segment
+}
+
+func (e pathExpr) eval(current, root any) any {
+	if e.relative {
+		return nodeList(evalSegments(e.segments, current, root))
+	}
+	return nodeList(evalSegments(e.segments, root, root))
+}
+
+type literalExpr struct {
+	value any
+}
+
+func (e literalExpr) eval(_, _ any) any {
+	return e.value
+}
+
+type notExpr struct {
+	inner expr
+}
+
+func (e notExpr) eval(current, root any) any {
+	return !truthy(e.inner.eval(current, root))
+}
+
+type andExpr struct {
+	left, right expr
+}
+
+func (e andExpr) eval(current, root any) any {
+	return truthy(e.left.eval(current, root)) && truthy(e.right.eval(current, root))
+}
+
+type orExpr struct {
+	left, right expr
+}
+
+func (e orExpr) eval(current, root any) any {
+	return truthy(e.left.eval(current, root)) || truthy(e.right.eval(current, root))
+}
+
+type comparisonExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e comparisonExpr) eval(current, root any) any {
+	left, lok := scalar(e.left.eval(current, root))
+	right, rok := scalar(e.right.eval(current, root))
+	if !lok || !rok {
+		switch e.op {
+		case "==", "<=", ">=":
+			return !lok && !rok
+		case "!=":
+			return lok != rok
+		}
+		return false
+	}
+
+	if ln, ok := toFloat(left); ok {
+		if rn, ok := toFloat(right); ok {
+			return compareOrdered(e.op, ln, rn)
+		}
+	}
+	if ls, ok := left.(string); ok {
+		if rs, ok := right.(string); ok {
+			return compareOrdered(e.op, ls, rs)
+		}
+	}
+
+	equal := left == nil && right == nil
+	if left != nil && right != nil && reflect.TypeOf(left).Comparable() && reflect.TypeOf(right).Comparable() {
+		equal = left == right
+	}
+	switch e.op {
+	case "==", "<=", ">=":
+		return equal
+	case "!=":
+		return !equal
+	}
+	return false
+}
+
+func compareOrdered[T float64 | string](op string, a, b T) bool {
+	switch op {
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+// scalar returns the single value of an operand, paths yield their first match.
+func scalar(v any) (any, bool) {
+	if nodes, ok := v.(nodeList); ok {
+		if len(nodes) == 0 {
+			return nil, false
+		}
+		return nodes[0], true
+	}
+	return v, true
+}
+
+func truthy(v any) bool {
+	switch t := v.(type) {
+	case nodeList:
+		return len(t) > 0
+	case bool:
+		return t
+	case nil:
+		return false
+	}
+	return true
+}
+
+func toFloat(v any) (float64, bool) {
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() >= reflect.Int && rv.Kind() <= reflect.Int64:
+		return float64(rv.Int()), true
+	case rv.Kind() >= reflect.Uint && rv.Kind() <= reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
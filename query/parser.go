@@ -0,0 +1,342 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type SyntaxError struct {
+	Expr   string
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("query: %s at offset %d in %q", e.Msg, e.Offset, e.Expr)
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func parse(src string) (segments []segment, err error) {
+	p := &parser{src: src}
+	defer func() {
+		if r := recover(); r != nil {
+			se, ok := r.(*SyntaxError)
+			if !ok {
+				panic(r)
+			}
+			segments, err = nil, se
+		}
+	}()
+
+	p.skipSpace()
+	p.expect("$")
+	segments = p.parseSegments()
+	p.skipSpace()
+	if !p.eof() {
+		p.fail("unexpected %q", p.src[p.pos:])
+	}
+	return segments, nil
+}
+
+func (p *parser) parseSegments() []segment {
+	var segments []segment
+	for {
+		switch {
+		case p.consume(".."):
+			if p.peek() == '[' {
+				segments = append(segments, segment{descendant: true, selectors: p.parseBracket()})
+			} else {
+				segments = append(segments, segment{descendant: true, selectors: []selector{p.parseDotted()}})
+			}
+		case p.consume("."):
+			segments = append(segments, segment{selectors: []selector{p.parseDotted()}})
+		case p.peek() == '[':
+			segments = append(segments, segment{selectors: p.parseBracket()})
+		default:
+			return segments
+		}
+	}
+}
+
+func (p *parser) parseDotted() selector {
+	if p.consume("*") {
+		return wildcardSelector{}
+	}
+	start := p.pos
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if r != '_' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		p.pos += size
+	}
+	if start == p.pos {
+		p.fail("expected member name")
+	}
+	return nameSelector(p.src[start:p.pos])
+}
+
+func (p *parser) parseBracket() []selector {
+	p.expect("[")
+	p.skipSpace()
+
+	var selectors []selector
+	for {
+		p.skipSpace()
+		switch c := p.peek(); {
+		case c == '*':
+			p.pos++
+			selectors = append(selectors, wildcardSelector{})
+		case c == '?':
+			p.pos++
+			p.skipSpace()
+			selectors = append(selectors, filterSelector{expr: p.parseOr()})
+		case c == '\'' || c == '"':
+			selectors = append(selectors, nameSelector(p.parseString()))
+		case c == '-' || c == ':' || isDigit(c):
+			selectors = append(selectors, p.parseIndexOrSlice())
+		default:
+			p.fail("unexpected %q in brackets", p.rest())
+		}
+
+		p.skipSpace()
+		if p.consume("]") {
+			return selectors
+		}
+		p.expect(",")
+	}
+}
+
+func (p *parser) parseIndexOrSlice() selector {
+	start, hasStart := p.parseOptionalInt()
+	p.skipSpace()
+	if !p.consume(":") {
+		if !hasStart {
+			p.fail("expected index")
+		}
+		return indexSelector(start)
+	}
+
+	s := sliceSelector{step: 1}
+	if hasStart {
+		s.start = &start
+	}
+	p.skipSpace()
+	if end, ok := p.parseOptionalInt(); ok {
+		s.end = &end
+	}
+	p.skipSpace()
+	if p.consume(":") {
+		p.skipSpace()
+		if step, ok := p.parseOptionalInt(); ok {
+			s.step = step
+		}
+	}
+	return s
+}
+
+func (p *parser) parseOptionalInt() (int, bool) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, false
+	}
+	n, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		p.pos = start
+		p.fail("invalid integer")
+	}
+	return n, true
+}
+
+func (p *parser) parseString() string {
+	quote := p.peek()
+	p.pos++
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			p.fail("unterminated string")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		switch {
+		case c == quote:
+			return sb.String()
+		case c == '\\':
+			if p.eof() {
+				p.fail("unterminated string")
+			}
+			e := p.src[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case '\\', '/', '\'', '"':
+				sb.WriteByte(e)
+			default:
+				p.pos -= 2
+				p.fail("invalid escape")
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+func (p *parser) parseOr() expr {
+	left := p.parseAnd()
+	for p.skipSpace(); p.consume("||"); p.skipSpace() {
+		left = orExpr{left: left, right: p.parseAnd()}
+	}
+	return left
+}
+
+func (p *parser) parseAnd() expr {
+	left := p.parseUnary()
+	for p.skipSpace(); p.consume("&&"); p.skipSpace() {
+		left = andExpr{left: left, right: p.parseUnary()}
+	}
+	return left
+}
+
+func (p *parser) parseUnary() expr {
+	p.skipSpace()
+	if p.peek() == '!' && !strings.HasPrefix(p.rest(), "!=") {
+		p.pos++
+		return notExpr{inner: p.parseUnary()}
+	}
+	return p.parseComparison()
+}
+
+var comparisonOps = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *parser) parseComparison() expr {
+	left := p.parsePrimary()
+	p.skipSpace()
+	for _, op := range comparisonOps {
+		if p.consume(op) {
+			p.skipSpace()
+			return comparisonExpr{op: op, left: left, right: p.parsePrimary()}
+		}
+	}
+	return left
+}
+
+func (p *parser) parsePrimary() expr {
+	p.skipSpace()
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		e := p.parseOr()
+		p.skipSpace()
+		p.expect(")")
+		return e
+	case c == '@' || c == '$':
+		p.pos++
+		return pathExpr{relative: c == '@', segments: p.parseSegments()}
+	case c == '\'' || c == '"':
+		return literalExpr{value: p.parseString()}
+	case c == '-' || isDigit(c):
+		return literalExpr{value: p.parseNumber()}
+	case p.consumeWord("true"):
+		return literalExpr{value: true}
+	case p.consumeWord("false"):
+		return literalExpr{value: false}
+	case p.consumeWord("null"):
+		return literalExpr{value: nil}
+	}
+	p.fail("unexpected %q in filter", p.rest())
+	return nil
+}
+
+func (p *parser) parseNumber() float64 {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for !p.eof() && strings.IndexByte("0123456789.eE+-", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		p.fail("invalid number")
+	}
+	return n
+}
+
+func (p *parser) consumeWord(word string) bool {
+	if !strings.HasPrefix(p.rest(), word) {
+		return false
+	}
+	if next := p.pos + len(word); next < len(p.src) {
+		r, _ := utf8.DecodeRuneInString(p.src[next:])
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	p.pos += len(word)
+	return true
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.rest(), s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) {
+	if !p.consume(s) {
+		if p.eof() {
+			p.fail("expected %q, got end of expression", s)
+		}
+		p.fail("expected %q, got %q", s, p.rest())
+	}
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t' || p.src[p.pos] == '\n' || p.src[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) rest() string {
+	return p.src[p.pos:]
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) fail(format string, args ...any) {
+	panic(&SyntaxError{Expr: p.src, Offset: p.pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
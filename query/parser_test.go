@@ -0,0 +1,50 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	for _, expr := range []string{
+		"$",
+		"$.a.b",
+		"$['a b'][\"c\"]",
+		"$..*",
+		"$..[0]",
+		"$[1:3:2]",
+		"$[ 0 , 'x' ]",
+		"$[?@.a]",
+		"$[?(!(@.a == null) || @.b <= -1.5e2)]",
+	} {
+		p, err := Compile(expr)
+		assert.Nil(t, err, expr)
+		assert.Equal(t, expr, p.String())
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	cases := []struct {
+		expr   string
+		offset int
+	}{
+		{"a.b", 0},
+		{"$.", 2},
+		{"$[0", 3},
+		{"$['a]", 5},
+		{"$[?(@.a > )]", 10},
+		{"$.a b", 4},
+		{"$[?(@.a == tru)]", 11},
+	}
+	for _, c := range cases {
+		_, err := Compile(c.expr)
+		var se *SyntaxError
+		if assert.True(t, errors.As(err, &se), c.expr) {
+			assert.Equal(t, c.offset, se.Offset, c.expr)
+		}
+	}
+
+	assert.Panics(t, func() { MustCompile("$[") })
+}
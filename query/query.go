@@ -0,0 +1,272 @@
+// Package query evaluates JSONPath-like expressions over decoded JSON data,
+// such as map[string]any and []any, including MapCollection and SliceCollection of any.
+//
+// Supported syntax:
+//
+//	$                      root
+//	.name ['name']         member
+//	.* [*]                 all members or elements
+//	[0] [-1] [0,2]         elements by index
+//	[1:3] [::2]            element slices
+//	..name ..*             recursive descent
+//	[?(@.total > 10)]      filters with == != < <= > >= && || ! and parentheses
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/wwaayyaa/go-collection/maps"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+type Path struct {
+	expr     string
+	segments []segment
+}
+
+func Compile(expr string) (*Path, error) {
+	segments, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Path{expr: expr, segments: segments}, nil
+}
+
+func MustCompile(expr string) *Path {
+	p, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (p *Path) String() string {
+	return p.expr
+}
+
+func (p *Path) Eval(data any) *slices.SliceCollection[any] {
+	return slices.NewSliceCollection(evalSegments(p.segments, data, data))
+}
+
+func (p *Path) First(data any) (any, bool) {
+	return p.Eval(data).First()
+}
+
+func Select(data any, expr string) (*slices.SliceCollection[any], error) {
+	p, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return p.Eval(data), nil
+}
+
+// As converts query results to T. Numbers are converted between numeric types
+// as long as no precision is lost, so JSON float64 values can be read as int.
+func As[T any](results *slices.SliceCollection[any]) ([]T, error) {
+	ret := make([]T, 0, results.Len())
+	for i, v := range results.All() {
+		t, ok := convert[T](v)
+		if !ok {
+			return nil, fmt.Errorf("query: result %d is %T, not %s", i, v, reflect.TypeOf((*T)(nil)).Elem())
+		}
+		ret = append(ret, t)
+	}
+	return ret, nil
+}
+
+func SelectAs[T any](data any, expr string) ([]T, error) {
+	results, err := Select(data, expr)
+	if err != nil {
+		return nil, err
+	}
+	return As[T](results)
+}
+
+func convert[T any](v any) (ret T, _ bool) {
+	if t, ok := v.(T); ok {
+		return t, true
+	}
+	typ := reflect.TypeOf(ret)
+	rv := reflect.ValueOf(v)
+	if typ == nil || !rv.IsValid() || !isNumber(rv.Kind()) || !isNumber(typ.Kind()) {
+		return ret, false
+	}
+	converted := rv.Convert(typ)
+	if converted.Convert(rv.Type()).Interface() != v {
+		return ret, false
+	}
+	return converted.Interface().(T), true
+}
+
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector interface {
+	selectFrom(node, root any, emit func(any))
+}
+
+func evalSegments(segments []segment, node, root any) []any {
+	nodes := []any{node}
+	for _, seg := range segments {
+		var next []any
+		emit := func(v any) { next = append(next, v) }
+		for _, n := range nodes {
+			targets := []any{n}
+			if seg.descendant {
+				targets = descendants(n, targets[:0])
+			}
+			for _, t := range targets {
+				for _, s := range seg.selectors {
+					s.selectFrom(t, root, emit)
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+type nameSelector string
+
+func (s nameSelector) selectFrom(node, _ any, emit func(any)) {
+	if v := unwrap(node); v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+		if child := v.MapIndex(reflect.ValueOf(string(s)).Convert(v.Type().Key())); child.IsValid() {
+			emit(child.Interface())
+		}
+	}
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectFrom(node, _ any, emit func(any)) {
+	for _, child := range children(node) {
+		emit(child)
+	}
+}
+
+type indexSelector int
+
+func (s indexSelector) selectFrom(node, _ any, emit func(any)) {
+	v := unwrap(node)
+	if !isList(v) {
+		return
+	}
+	i := int(s)
+	if i < 0 {
+		i += v.Len()
+	}
+	if i >= 0 && i < v.Len() {
+		emit(v.Index(i).Interface())
+	}
+}
+
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) selectFrom(node, _ any, emit func(any)) {
+	v := unwrap(node)
+	if !isList(v) || s.step == 0 {
+		return
+	}
+	n := v.Len()
+	normalize := func(i *int, def, lower, upper int) int {
+		if i == nil {
+			return def
+		}
+		idx := *i
+		if idx < 0 {
+			idx += n
+		}
+		if idx < lower {
+			return lower
+		}
+		if idx > upper {
+			return upper
+		}
+		return idx
+	}
+
+	// compare the step with the remaining distance before adding it, a huge step would overflow.
+	if s.step > 0 {
+		for i, end := normalize(s.start, 0, 0, n), normalize(s.end, n, 0, n); i < end; i += s.step {
+			emit(v.Index(i).Interface())
+			if s.step >= end-i {
+				break
+			}
+		}
+		return
+	}
+	for i, end := normalize(s.start, n-1, -1, n-1), normalize(s.end, -1, -1, n-1); i > end; i += s.step {
+		emit(v.Index(i).Interface())
+		if s.step <= end-i {
+			break
+		}
+	}
+}
+
+type filterSelector struct {
+	expr expr
+}
+
+func (s filterSelector) selectFrom(node, root any, emit func(any)) {
+	for _, child := range children(node) {
+		if truthy(s.expr.eval(child, root)) {
+			emit(child)
+		}
+	}
+}
+
+func descendants(node any, ret []any) []any {
+	ret = append(ret, node)
+	for _, child := range children(node) {
+		ret = descendants(child, ret)
+	}
+	return ret
+}
+
+// children returns list elements in order, or map values ordered by key.
+func children(node any) []any {
+	v := unwrap(node)
+	var ret []any
+	switch {
+	case isList(v):
+		for i := 0; i < v.Len(); i++ {
+			ret = append(ret, v.Index(i).Interface())
+		}
+	case v.Kind() == reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, k := range keys {
+			ret = append(ret, v.MapIndex(k).Interface())
+		}
+	}
+	return ret
+}
+
+func unwrap(node any) reflect.Value {
+	switch n := node.(type) {
+	case *maps.MapCollection[string, any]:
+		return reflect.ValueOf(n.All())
+	case *slices.SliceCollection[any]:
+		return reflect.ValueOf(n.All())
+	}
+	v := reflect.ValueOf(node)
+	for (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/maps"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+const store = `{
+	"name": "shop",
+	"orders": [
+		{"id": 1, "total": 5, "status": "open", "items": [{"sku": "a"}]},
+		{"id": 2, "total": 15.5, "status": "paid", "items": [{"sku": "b"}, {"sku": "c"}]},
+		{"id": 3, "total": 42, "status": "paid", "coupon": "X", "items": []}
+	],
+	"owner": {"name": "bob", "tags": ["x", "y"]}
+}`
+
+func decode(t *testing.T) map[string]any {
+	var data map[string]any
+	assert.Nil(t, json.Unmarshal([]byte(store), &data))
+	return data
+}
+
+func TestPath_Eval(t *testing.T) {
+	data := decode(t)
+	cases := []struct {
+		expr     string
+		expected []any
+	}{
+		{"$.name", []any{"shop"}},
+		{"$['owner']['name']", []any{"bob"}},
+		{"$.owner.tags[*]", []any{"x", "y"}},
+		{"$.orders[0].id", []any{1.0}},
+		{"$.orders[-1].id", []any{3.0}},
+		{"$.orders[0,2].id", []any{1.0, 3.0}},
+		{"$.orders[1:].id", []any{2.0, 3.0}},
+		{"$.orders[::-1].id", []any{3.0, 2.0, 1.0}},
+		{"$.orders[1::9223372036854775807].id", []any{2.0}},
+		{"$.orders[1::-9223372036854775808].id", []any{2.0}},
+		{"$.orders[?(@.total > 10)].id", []any{2.0, 3.0}},
+		{"$.orders[?(@.total > 10 && @.status == 'paid' && !@.coupon)].id", []any{2.0}},
+		{"$.orders[?(@.coupon || @.id == 1)].id", []any{1.0, 3.0}},
+		{`$.orders[?(@.status != "paid")].id`, []any{1.0}},
+		{"$.orders[?(@.total >= $.orders[1].total)].id", []any{2.0, 3.0}},
+		{"$..sku", []any{"a", "b", "c"}},
+		{"$.owner.*", []any{"bob", []any{"x", "y"}}},
+		{"$.missing", nil},
+		{"$.orders[9]", nil},
+	}
+	for _, c := range cases {
+		actual := MustCompile(c.expr).Eval(data).All()
+		assert.Equal(t, c.expected, actual, c.expr)
+	}
+}
+
+func TestPath_EvalCollections(t *testing.T) {
+	co := maps.NewMapCollection(map[string]any{
+		"users": slices.NewSliceCollection([]any{
+			maps.NewMapCollection(map[string]any{"name": "a", "age": 20}),
+			maps.NewMapCollection(map[string]any{"name": "b", "age": 30}),
+		}),
+	})
+	expected := []any{"b"}
+	actual := MustCompile("$.users[?(@.age > 25)].name").Eval(co).All()
+	assert.Equal(t, expected, actual)
+
+	first, ok := MustCompile("$.users[0].age").First(co)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 20, first)
+}
+
+func TestSelectAs(t *testing.T) {
+	data := decode(t)
+	ids, err := SelectAs[int](data, "$.orders[*].id")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	names, err := SelectAs[string](data, "$..name")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"shop", "bob"}, names)
+
+	_, err = SelectAs[int](data, "$.orders[*].total")
+	assert.NotNil(t, err)
+
+	_, err = SelectAs[string](data, "$.orders[*].id")
+	assert.NotNil(t, err)
+
+	_, err = SelectAs[string](data, "$.orders[")
+	assert.NotNil(t, err)
+}
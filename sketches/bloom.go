@@ -0,0 +1,133 @@
+package sketches
+
+import (
+	"math"
+
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// BloomFilter answers set membership with no false negatives and a bounded false-positive rate.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64
+	k    uint64
+	n    uint64
+}
+
+// NewBloomFilter sizes a filter to hold n items with the given false-positive rate.
+func NewBloomFilter(n int, fpRate float64) *BloomFilter {
+	if fpRate <= 0 || fpRate >= 1 {
+		panic("sketches: false-positive rate must be in (0, 1)")
+	}
+	if n < 1 {
+		n = 1
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	if k > maxBloomHashes {
+		k = maxBloomHashes
+	}
+	return &BloomFilter{bits: make([]uint64, bloomWords(m)), m: m, k: k}
+}
+
+// maxBloomHashes bounds k when decoding, even a false-positive rate of 1e-19 only needs 63 hashes.
+const maxBloomHashes = 64
+
+// bloomWords returns the number of uint64 words holding m bits without overflowing for large m.
+func bloomWords(m uint64) uint64 {
+	words := m / 64
+	if m%64 != 0 {
+		words++
+	}
+	return words
+}
+
+// BloomFilterFrom builds a filter sized for the collection and adds every item to it.
+func BloomFilterFrom[T any](co *slices.SliceCollection[T], fpRate float64, key func(T) []byte) *BloomFilter {
+	f := NewBloomFilter(co.Len(), fpRate)
+	co.Each(func(v T, _ int) bool {
+		f.Add(key(v))
+		return true
+	})
+	return f
+}
+
+func (f *BloomFilter) Add(data []byte) *BloomFilter {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+	f.n++
+	return f
+}
+
+func (f *BloomFilter) AddString(s string) *BloomFilter {
+	return f.Add([]byte(s))
+}
+
+// Test reports whether data may have been added, false means it definitely was not.
+func (f *BloomFilter) Test(data []byte) bool {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *BloomFilter) TestString(s string) bool {
+	return f.Test([]byte(s))
+}
+
+// Count returns how many times Add was called, including duplicates.
+func (f *BloomFilter) Count() int {
+	return int(f.n)
+}
+
+// FalsePositiveRate estimates the current false-positive rate from the number of added items.
+func (f *BloomFilter) FalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.n)/float64(f.m)), float64(f.k))
+}
+
+// Merge adds every item of other to the filter, both filters must have the same size.
+func (f *BloomFilter) Merge(other *BloomFilter) error {
+	if f.m != other.m || f.k != other.k {
+		return ErrIncompatible
+	}
+	for i, w := range other.bits {
+		f.bits[i] |= w
+	}
+	f.n += other.n
+	return nil
+}
+
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	e := &encoder{}
+	e.uint64(f.m)
+	e.uint64(f.k)
+	e.uint64(f.n)
+	for _, w := range f.bits {
+		e.uint64(w)
+	}
+	return e.buf, nil
+}
+
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	d := &decoder{buf: data}
+	m, k, n := d.uint64(), d.uint64(), d.uint64()
+	if d.err != nil || m == 0 || k == 0 || k > maxBloomHashes || len(d.buf)%8 != 0 || uint64(len(d.buf)/8) != bloomWords(m) {
+		return ErrCorrupt
+	}
+	bits := make([]uint64, len(d.buf)/8)
+	for i := range bits {
+		bits[i] = d.uint64()
+	}
+	f.bits, f.m, f.k, f.n = bits, m, k, n
+	return nil
+}
@@ -0,0 +1,79 @@
+package sketches
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestBloomFilter_AddTest(t *testing.T) {
+	f := NewBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.AddString(strconv.Itoa(i))
+	}
+	for i := 0; i < 1000; i++ {
+		assert.Equal(t, true, f.TestString(strconv.Itoa(i)))
+	}
+	assert.Equal(t, 1000, f.Count())
+
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.TestString(strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, float64(falsePositives)/10000, 0.03)
+	assert.InDelta(t, 0.01, f.FalsePositiveRate(), 0.005)
+	assert.Panics(t, func() { NewBloomFilter(10, 0) })
+}
+
+func TestBloomFilterFrom(t *testing.T) {
+	co := slices.NewSliceCollection([]string{"a", "b", "c"})
+	f := BloomFilterFrom(co, 0.01, func(s string) []byte { return []byte(s) })
+	assert.Equal(t, true, f.TestString("b"))
+	assert.Equal(t, false, f.TestString("z"))
+}
+
+func TestBloomFilter_Merge(t *testing.T) {
+	a := NewBloomFilter(100, 0.01).AddString("a")
+	b := NewBloomFilter(100, 0.01).AddString("b")
+	assert.Nil(t, a.Merge(b))
+	assert.Equal(t, true, a.TestString("a"))
+	assert.Equal(t, true, a.TestString("b"))
+	assert.Equal(t, 2, a.Count())
+	assert.ErrorIs(t, a.Merge(NewBloomFilter(100, 0.1)), ErrIncompatible)
+}
+
+func TestBloomFilter_MarshalBinary(t *testing.T) {
+	f := NewBloomFilter(100, 0.01).AddString("a").AddString("b")
+	data, err := f.MarshalBinary()
+	assert.Nil(t, err)
+
+	actual := &BloomFilter{}
+	assert.Nil(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, f, actual)
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:len(data)-1]), ErrCorrupt)
+}
+
+func TestBloomFilter_UnmarshalBinaryCorruptHeader(t *testing.T) {
+	header := func(m, k, n uint64, words int) []byte {
+		e := &encoder{}
+		e.uint64(m)
+		e.uint64(k)
+		e.uint64(n)
+		for i := 0; i < words; i++ {
+			e.uint64(0)
+		}
+		return e.buf
+	}
+	f := NewBloomFilter(10, 0.01)
+	assert.ErrorIs(t, f.UnmarshalBinary(header(math.MaxUint64, 3, 0, 0)), ErrCorrupt)
+	assert.ErrorIs(t, f.UnmarshalBinary(header(math.MaxUint64-62, 3, 0, 0)), ErrCorrupt)
+	assert.ErrorIs(t, f.UnmarshalBinary(header(128, 3, 0, 1)), ErrCorrupt)
+	assert.ErrorIs(t, f.UnmarshalBinary(header(128, 1000, 0, 2)), ErrCorrupt)
+	assert.Nil(t, f.UnmarshalBinary(header(128, 3, 0, 2)))
+	assert.Equal(t, false, f.TestString("a"))
+}
@@ -0,0 +1,112 @@
+package sketches
+
+import (
+	"math"
+
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// CountMinSketch estimates item frequencies. Estimates never undercount and overcount
+// by at most epsilon * Total() with probability 1 - delta.
+type CountMinSketch struct {
+	width  uint64
+	depth  uint64
+	counts []uint64
+	total  uint64
+}
+
+func NewCountMinSketch(epsilon, delta float64) *CountMinSketch {
+	if epsilon <= 0 || delta <= 0 || delta >= 1 {
+		panic("sketches: epsilon must be positive and delta in (0, 1)")
+	}
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := uint64(math.Ceil(math.Log(1 / delta)))
+	if depth < 1 {
+		depth = 1
+	}
+	return &CountMinSketch{width: width, depth: depth, counts: make([]uint64, width*depth)}
+}
+
+func CountMinSketchFrom[T any](co *slices.SliceCollection[T], epsilon, delta float64, key func(T) []byte) *CountMinSketch {
+	s := NewCountMinSketch(epsilon, delta)
+	co.Each(func(v T, _ int) bool {
+		s.Add(key(v))
+		return true
+	})
+	return s
+}
+
+func (s *CountMinSketch) Add(data []byte) *CountMinSketch {
+	return s.AddN(data, 1)
+}
+
+func (s *CountMinSketch) AddString(str string) *CountMinSketch {
+	return s.AddN([]byte(str), 1)
+}
+
+func (s *CountMinSketch) AddN(data []byte, n uint64) *CountMinSketch {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < s.depth; i++ {
+		s.counts[i*s.width+(h1+i*h2)%s.width] += n
+	}
+	s.total += n
+	return s
+}
+
+func (s *CountMinSketch) Estimate(data []byte) uint64 {
+	h1, h2 := hashes(data)
+	min := uint64(math.MaxUint64)
+	for i := uint64(0); i < s.depth; i++ {
+		if c := s.counts[i*s.width+(h1+i*h2)%s.width]; c < min {
+			min = c
+		}
+	}
+	return min
+}
+
+func (s *CountMinSketch) EstimateString(str string) uint64 {
+	return s.Estimate([]byte(str))
+}
+
+func (s *CountMinSketch) Total() uint64 {
+	return s.total
+}
+
+// Merge adds the counts of other, both sketches must have the same dimensions.
+func (s *CountMinSketch) Merge(other *CountMinSketch) error {
+	if s.width != other.width || s.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i, c := range other.counts {
+		s.counts[i] += c
+	}
+	s.total += other.total
+	return nil
+}
+
+func (s *CountMinSketch) MarshalBinary() ([]byte, error) {
+	e := &encoder{}
+	e.uint64(s.width)
+	e.uint64(s.depth)
+	e.uint64(s.total)
+	for _, c := range s.counts {
+		e.uint64(c)
+	}
+	return e.buf, nil
+}
+
+func (s *CountMinSketch) UnmarshalBinary(data []byte) error {
+	d := &decoder{buf: data}
+	width, depth, total := d.uint64(), d.uint64(), d.uint64()
+	// divide instead of multiplying width by depth, the header may be crafted to overflow.
+	cells := uint64(len(d.buf) / 8)
+	if d.err != nil || width == 0 || depth == 0 || len(d.buf)%8 != 0 || cells%depth != 0 || cells/depth != width {
+		return ErrCorrupt
+	}
+	counts := make([]uint64, cells)
+	for i := range counts {
+		counts[i] = d.uint64()
+	}
+	s.width, s.depth, s.counts, s.total = width, depth, counts, total
+	return nil
+}
@@ -0,0 +1,74 @@
+package sketches
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestCountMinSketch_Estimate(t *testing.T) {
+	s := NewCountMinSketch(0.001, 0.01)
+	for i := 0; i < 1000; i++ {
+		s.AddString(strconv.Itoa(i % 100))
+	}
+	s.AddN([]byte("hot"), 500)
+
+	assert.Equal(t, uint64(1500), s.Total())
+	for i := 0; i < 100; i++ {
+		estimate := s.EstimateString(strconv.Itoa(i))
+		assert.GreaterOrEqual(t, estimate, uint64(10))
+		assert.LessOrEqual(t, estimate, uint64(10+2))
+	}
+	assert.GreaterOrEqual(t, s.EstimateString("hot"), uint64(500))
+	assert.Panics(t, func() { NewCountMinSketch(0, 0.01) })
+}
+
+func TestCountMinSketchFrom(t *testing.T) {
+	co := slices.NewSliceCollection([]string{"a", "b", "a", "a"})
+	s := CountMinSketchFrom(co, 0.01, 0.01, func(v string) []byte { return []byte(v) })
+	assert.Equal(t, uint64(3), s.EstimateString("a"))
+	assert.Equal(t, uint64(1), s.EstimateString("b"))
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	a := NewCountMinSketch(0.01, 0.01).AddString("a")
+	b := NewCountMinSketch(0.01, 0.01).AddString("a").AddString("b")
+	assert.Nil(t, a.Merge(b))
+	assert.Equal(t, uint64(2), a.EstimateString("a"))
+	assert.Equal(t, uint64(3), a.Total())
+	assert.ErrorIs(t, a.Merge(NewCountMinSketch(0.1, 0.01)), ErrIncompatible)
+}
+
+func TestCountMinSketch_MarshalBinary(t *testing.T) {
+	s := NewCountMinSketch(0.01, 0.01).AddString("a").AddN([]byte("b"), 3)
+	data, err := s.MarshalBinary()
+	assert.Nil(t, err)
+
+	actual := &CountMinSketch{}
+	assert.Nil(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, s, actual)
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:10]), ErrCorrupt)
+}
+
+func TestCountMinSketch_UnmarshalBinaryCorruptHeader(t *testing.T) {
+	header := func(width, depth uint64, cells int) []byte {
+		e := &encoder{}
+		e.uint64(width)
+		e.uint64(depth)
+		e.uint64(0)
+		for i := 0; i < cells; i++ {
+			e.uint64(0)
+		}
+		return e.buf
+	}
+	s := NewCountMinSketch(0.1, 0.1)
+	// 2^32 * 2^32 overflows to 0 cells.
+	assert.ErrorIs(t, s.UnmarshalBinary(header(1<<32, 1<<32, 0)), ErrCorrupt)
+	assert.ErrorIs(t, s.UnmarshalBinary(header(math.MaxUint64, 2, 2)), ErrCorrupt)
+	assert.ErrorIs(t, s.UnmarshalBinary(header(3, 2, 5)), ErrCorrupt)
+	assert.Nil(t, s.UnmarshalBinary(header(3, 2, 6)))
+	assert.Equal(t, uint64(0), s.EstimateString("a"))
+}
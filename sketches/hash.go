@@ -0,0 +1,48 @@
+// Package sketches provides probabilistic summaries of large streams: Bloom filters,
+// Count-Min sketches, quantile sketches and histograms.
+package sketches
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+)
+
+var (
+	ErrIncompatible = errors.New("sketches: sketches have different parameters")
+	ErrCorrupt      = errors.New("sketches: invalid serialized data")
+)
+
+// hashes returns two independent hashes of data, the i-th derived hash is h1 + i*h2.
+func hashes(data []byte) (uint64, uint64) {
+	a := fnv.New64a()
+	a.Write(data)
+	b := fnv.New64()
+	b.Write(data)
+	return a.Sum64(), b.Sum64() | 1
+}
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) uint64(v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) uint64() uint64 {
+	if len(d.buf) < 8 {
+		d.err = ErrCorrupt
+		return 0
+	}
+	v := binary.BigEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
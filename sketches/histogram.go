@@ -0,0 +1,168 @@
+package sketches
+
+import (
+	"math"
+	"sort"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+// Bucket counts the values in (previous bucket's Upper, Upper], the last bucket's Upper is +Inf.
+type Bucket struct {
+	Upper float64
+	Count uint64
+}
+
+// BucketHistogram counts values into buckets with fixed upper bounds.
+type BucketHistogram struct {
+	bounds []float64
+	counts []uint64
+	total  uint64
+	sum    float64
+	min    float64
+	max    float64
+}
+
+// NewBucketHistogram creates a histogram with the given upper bounds, an overflow bucket
+// up to +Inf is always added. Bounds are sorted and deduplicated.
+func NewBucketHistogram(bounds []float64) *BucketHistogram {
+	sorted := make([]float64, 0, len(bounds))
+	for _, b := range bounds {
+		if math.IsNaN(b) || math.IsInf(b, 1) {
+			continue
+		}
+		sorted = append(sorted, b)
+	}
+	sort.Float64s(sorted)
+	uniq := sorted[:0]
+	for i, b := range sorted {
+		if i == 0 || b != sorted[i-1] {
+			uniq = append(uniq, b)
+		}
+	}
+	return &BucketHistogram{
+		bounds: uniq,
+		counts: make([]uint64, len(uniq)+1),
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+	}
+}
+
+// Histogram counts the items of the collection into buckets with the given upper bounds.
+func Histogram[T go_collection.Number](co *slices.SliceCollection[T], buckets []float64) *BucketHistogram {
+	h := NewBucketHistogram(buckets)
+	co.Each(func(v T, _ int) bool {
+		h.Add(float64(v))
+		return true
+	})
+	return h
+}
+
+func (h *BucketHistogram) Add(v float64) *BucketHistogram {
+	return h.AddN(v, 1)
+}
+
+// AddN adds v n times, NaN values are ignored.
+func (h *BucketHistogram) AddN(v float64, n uint64) *BucketHistogram {
+	if math.IsNaN(v) || n == 0 {
+		return h
+	}
+	h.counts[sort.SearchFloat64s(h.bounds, v)] += n
+	h.total += n
+	h.sum += v * float64(n)
+	h.min = math.Min(h.min, v)
+	h.max = math.Max(h.max, v)
+	return h
+}
+
+// Merge adds the counts of other, both histograms must have the same bounds.
+func (h *BucketHistogram) Merge(other *BucketHistogram) error {
+	if len(h.bounds) != len(other.bounds) {
+		return ErrIncompatible
+	}
+	for i, b := range other.bounds {
+		if h.bounds[i] != b {
+			return ErrIncompatible
+		}
+	}
+	for i, c := range other.counts {
+		h.counts[i] += c
+	}
+	h.total += other.total
+	h.sum += other.sum
+	h.min = math.Min(h.min, other.min)
+	h.max = math.Max(h.max, other.max)
+	return nil
+}
+
+func (h *BucketHistogram) Buckets() []Bucket {
+	ret := make([]Bucket, len(h.counts))
+	for i, c := range h.counts {
+		ret[i] = Bucket{Upper: math.Inf(1), Count: c}
+		if i < len(h.bounds) {
+			ret[i].Upper = h.bounds[i]
+		}
+	}
+	return ret
+}
+
+func (h *BucketHistogram) Count() uint64 {
+	return h.total
+}
+
+func (h *BucketHistogram) Sum() float64 {
+	return h.sum
+}
+
+func (h *BucketHistogram) Mean() float64 {
+	if h.total == 0 {
+		return math.NaN()
+	}
+	return h.sum / float64(h.total)
+}
+
+func (h *BucketHistogram) Min() float64 {
+	if h.total == 0 {
+		return math.NaN()
+	}
+	return h.min
+}
+
+func (h *BucketHistogram) Max() float64 {
+	if h.total == 0 {
+		return math.NaN()
+	}
+	return h.max
+}
+
+// Quantile estimates the q-th quantile by interpolating linearly inside the bucket it falls in.
+// Buckets are clamped to the observed min and max. It returns NaN for an empty histogram.
+func (h *BucketHistogram) Quantile(q float64) float64 {
+	if h.total == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return h.min
+	}
+	if q >= 1 {
+		return h.max
+	}
+	target := q * float64(h.total)
+	seen := 0.0
+	for i, c := range h.counts {
+		if c == 0 || seen+float64(c) < target {
+			seen += float64(c)
+			continue
+		}
+		lower, upper := h.min, h.max
+		if i > 0 {
+			lower = math.Max(lower, h.bounds[i-1])
+		}
+		if i < len(h.bounds) {
+			upper = math.Min(upper, h.bounds[i])
+		}
+		return interpolate(target, seen, seen+float64(c), lower, upper)
+	}
+	return h.max
+}
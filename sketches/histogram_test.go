@@ -0,0 +1,45 @@
+package sketches
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestHistogram(t *testing.T) {
+	h := Histogram(slices.NewSliceCollection([]int{1, 5, 10, 11, 50, 200}), []float64{100, 10, 50, 10})
+	expected := []Bucket{
+		{Upper: 10, Count: 3},
+		{Upper: 50, Count: 2},
+		{Upper: 100, Count: 0},
+		{Upper: math.Inf(1), Count: 1},
+	}
+	assert.Equal(t, expected, h.Buckets())
+	assert.Equal(t, uint64(6), h.Count())
+	assert.Equal(t, float64(277), h.Sum())
+	assert.Equal(t, float64(1), h.Min())
+	assert.Equal(t, float64(200), h.Max())
+}
+
+func TestBucketHistogram_Quantile(t *testing.T) {
+	h := NewBucketHistogram([]float64{10, 20, 30, 40})
+	for i := 1; i <= 40; i++ {
+		h.Add(float64(i))
+	}
+	assert.Equal(t, float64(20), h.Quantile(0.5))
+	assert.Equal(t, float64(5.5), h.Quantile(0.125))
+	assert.Equal(t, float64(1), h.Quantile(0))
+	assert.Equal(t, float64(40), h.Quantile(1))
+	assert.True(t, math.IsNaN(NewBucketHistogram(nil).Quantile(0.5)))
+}
+
+func TestBucketHistogram_Merge(t *testing.T) {
+	a := NewBucketHistogram([]float64{10}).Add(1).AddN(20, 2)
+	b := NewBucketHistogram([]float64{10}).Add(5)
+	assert.Nil(t, a.Merge(b))
+	assert.Equal(t, []Bucket{{Upper: 10, Count: 2}, {Upper: math.Inf(1), Count: 2}}, a.Buckets())
+	assert.Equal(t, float64(11.5), a.Mean())
+	assert.ErrorIs(t, a.Merge(NewBucketHistogram([]float64{20})), ErrIncompatible)
+}
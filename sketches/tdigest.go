@@ -0,0 +1,186 @@
+package sketches
+
+import (
+	"math"
+	"sort"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest estimates quantiles of a stream with accuracy that is best near the tails.
+// Higher compression keeps more centroids and gives more accurate results.
+// A TDigest is not safe for concurrent use, queries may compact its buffer.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+// NewTDigest creates a digest, a compression of 100 is a good default.
+func NewTDigest(compression float64) *TDigest {
+	if compression < 1 {
+		panic("sketches: compression must be at least 1")
+	}
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+func TDigestFrom[T go_collection.Number](co *slices.SliceCollection[T], compression float64) *TDigest {
+	d := NewTDigest(compression)
+	co.Each(func(v T, _ int) bool {
+		d.Add(float64(v))
+		return true
+	})
+	return d
+}
+
+func (d *TDigest) Add(v float64) *TDigest {
+	return d.AddWeighted(v, 1)
+}
+
+// AddWeighted adds v as if it was seen weight times, NaN values and non-positive weights are ignored.
+func (d *TDigest) AddWeighted(v, weight float64) *TDigest {
+	if math.IsNaN(v) || weight <= 0 {
+		return d
+	}
+	d.buffer = append(d.buffer, centroid{mean: v, weight: weight})
+	d.count += weight
+	d.min = math.Min(d.min, v)
+	d.max = math.Max(d.max, v)
+	if len(d.buffer) >= int(5*d.compression) {
+		d.compress()
+	}
+	return d
+}
+
+// Merge adds every value summarized by other, digests with different compressions can be merged.
+func (d *TDigest) Merge(other *TDigest) *TDigest {
+	other.compress()
+	if len(other.centroids) == 0 {
+		return d
+	}
+	d.buffer = append(d.buffer, other.centroids...)
+	d.count += other.count
+	d.min = math.Min(d.min, other.min)
+	d.max = math.Max(d.max, other.max)
+	d.compress()
+	return d
+}
+
+// Count returns the total weight added.
+func (d *TDigest) Count() float64 {
+	return d.count
+}
+
+func (d *TDigest) Min() float64 {
+	if d.count == 0 {
+		return math.NaN()
+	}
+	return d.min
+}
+
+func (d *TDigest) Max() float64 {
+	if d.count == 0 {
+		return math.NaN()
+	}
+	return d.max
+}
+
+// Quantile returns the estimated value below which a fraction q of the weight lies.
+// It returns NaN for an empty digest.
+func (d *TDigest) Quantile(q float64) float64 {
+	if d.count == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+	values, ranks := d.points()
+	target := q * d.count
+	i := sort.SearchFloat64s(ranks, target)
+	if ranks[i] == target {
+		return values[i]
+	}
+	return interpolate(target, ranks[i-1], ranks[i], values[i-1], values[i])
+}
+
+// CDF returns the estimated fraction of the weight that is less than or equal to x.
+func (d *TDigest) CDF(x float64) float64 {
+	if d.count == 0 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < d.min {
+		return 0
+	}
+	if x >= d.max {
+		return 1
+	}
+	values, ranks := d.points()
+	i := sort.Search(len(values), func(i int) bool { return values[i] > x })
+	return interpolate(x, values[i-1], values[i], ranks[i-1], ranks[i]) / d.count
+}
+
+// points returns the piecewise linear approximation of the distribution as parallel
+// slices of values and cumulative weights, from (min, 0) to (max, count).
+func (d *TDigest) points() ([]float64, []float64) {
+	d.compress()
+	values := make([]float64, 0, len(d.centroids)+2)
+	ranks := make([]float64, 0, len(d.centroids)+2)
+	values, ranks = append(values, d.min), append(ranks, 0)
+	cumulative := 0.0
+	for _, c := range d.centroids {
+		values = append(values, c.mean)
+		ranks = append(ranks, cumulative+c.weight/2)
+		cumulative += c.weight
+	}
+	return append(values, d.max), append(ranks, d.count)
+}
+
+// compress merges the buffer into the centroids, keeping each centroid within
+// one unit of the k1 scale function so that centroids near the tails stay small.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := append(d.centroids, d.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	scale := func(q float64) float64 {
+		return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+	}
+	merged := make([]centroid, 0, len(all))
+	cur := all[0]
+	seen := 0.0
+	lower := scale(0)
+	for _, c := range all[1:] {
+		if scale((seen+cur.weight+c.weight)/d.count)-lower <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		merged = append(merged, cur)
+		seen += cur.weight
+		lower = scale(seen / d.count)
+		cur = c
+	}
+	d.centroids = append(merged, cur)
+	d.buffer = d.buffer[:0]
+}
+
+func interpolate(x, x0, x1, y0, y1 float64) float64 {
+	if x1 == x0 {
+		return y1
+	}
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
@@ -0,0 +1,55 @@
+package sketches
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+func TestTDigest_Quantile(t *testing.T) {
+	d := NewTDigest(100)
+	for i := 0; i < 10000; i++ {
+		d.Add(float64(i * 7919 % 10000))
+	}
+	assert.Equal(t, float64(10000), d.Count())
+	assert.Equal(t, float64(0), d.Quantile(0))
+	assert.Equal(t, float64(9999), d.Quantile(1))
+	for _, q := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+		assert.InDelta(t, q*10000, d.Quantile(q), 50, "q=%v", q)
+	}
+	assert.InDelta(t, 0.25, d.CDF(2500), 0.005)
+	assert.Equal(t, float64(0), d.CDF(-1))
+	assert.Equal(t, float64(1), d.CDF(10000))
+	assert.Less(t, len(d.centroids), 200)
+
+	assert.True(t, math.IsNaN(NewTDigest(100).Quantile(0.5)))
+	assert.Panics(t, func() { NewTDigest(0) })
+}
+
+func TestTDigest_Merge(t *testing.T) {
+	workers := make([]*TDigest, 4)
+	for w := range workers {
+		workers[w] = NewTDigest(100)
+		for i := w; i < 10000; i += len(workers) {
+			workers[w].Add(float64(i))
+		}
+	}
+	d := NewTDigest(100)
+	for _, w := range workers {
+		d.Merge(w)
+	}
+	assert.Equal(t, float64(10000), d.Count())
+	assert.Equal(t, float64(0), d.Min())
+	assert.Equal(t, float64(9999), d.Max())
+	assert.InDelta(t, 5000, d.Quantile(0.5), 50)
+	assert.InDelta(t, 9900, d.Quantile(0.99), 20)
+}
+
+func TestTDigestFrom(t *testing.T) {
+	d := TDigestFrom(slices.NewSliceCollection([]int{5, 1, 3}), 100)
+	assert.Equal(t, float64(3), d.Quantile(0.5))
+	assert.Equal(t, float64(1), d.Min())
+	assert.Equal(t, float64(5), d.Max())
+}
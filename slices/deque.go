@@ -0,0 +1,134 @@
+package slices
+
+// Deque is a double-ended queue backed by a growable circular buffer,
+// pushing and popping at either end is O(1) amortized.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func NewDeque[T any](v []T) *Deque[T] {
+	co := &Deque[T]{buf: make([]T, len(v))}
+	co.size = copy(co.buf, v)
+	return co
+}
+
+func (co *Deque[T]) Len() int {
+	return co.size
+}
+
+func (co *Deque[T]) Empty() bool {
+	return co.size == 0
+}
+
+func (co *Deque[T]) Get(i int) (ret T, _ bool) {
+	if i < 0 || i >= co.size {
+		return ret, false
+	}
+	return co.buf[co.index(i)], true
+}
+
+func (co *Deque[T]) Front() (T, bool) {
+	return co.Get(0)
+}
+
+func (co *Deque[T]) Back() (T, bool) {
+	return co.Get(co.size - 1)
+}
+
+func (co *Deque[T]) PushBack(v T) *Deque[T] {
+	co.grow()
+	co.buf[co.index(co.size)] = v
+	co.size++
+	return co
+}
+
+func (co *Deque[T]) PushFront(v T) *Deque[T] {
+	co.grow()
+	co.head = co.index(len(co.buf) - 1)
+	co.buf[co.head] = v
+	co.size++
+	return co
+}
+
+func (co *Deque[T]) PopFront() (ret T, _ bool) {
+	if co.size == 0 {
+		return ret, false
+	}
+	var zero T
+	ret, co.buf[co.head] = co.buf[co.head], zero
+	co.head = co.index(1)
+	co.size--
+	co.shrink()
+	return ret, true
+}
+
+func (co *Deque[T]) PopBack() (ret T, _ bool) {
+	if co.size == 0 {
+		return ret, false
+	}
+	var zero T
+	i := co.index(co.size - 1)
+	ret, co.buf[i] = co.buf[i], zero
+	co.size--
+	co.shrink()
+	return ret, true
+}
+
+func (co *Deque[T]) Each(fn func(T, int) bool) *Deque[T] {
+	for i := 0; i < co.size; i++ {
+		if !fn(co.buf[co.index(i)], i) {
+			break
+		}
+	}
+	return co
+}
+
+func (co *Deque[T]) Clear() *Deque[T] {
+	co.buf, co.head, co.size = nil, 0, 0
+	return co
+}
+
+func (co *Deque[T]) All() []T {
+	ret := make([]T, co.size)
+	n := copy(ret, co.buf[co.head:])
+	if n < co.size {
+		copy(ret[n:], co.buf[:co.size-n])
+	}
+	return ret
+}
+
+func (co *Deque[T]) ToSliceCollection() *SliceCollection[T] {
+	return &SliceCollection[T]{items: co.All()}
+}
+
+func (co *Deque[T]) index(i int) int {
+	return (co.head + i) % len(co.buf)
+}
+
+func (co *Deque[T]) grow() {
+	if co.size < len(co.buf) {
+		return
+	}
+	capacity := len(co.buf) * 2
+	if capacity == 0 {
+		capacity = 8
+	}
+	co.resize(capacity)
+}
+
+func (co *Deque[T]) shrink() {
+	if len(co.buf) > 8 && co.size <= len(co.buf)/4 {
+		co.resize(len(co.buf) / 2)
+	}
+}
+
+func (co *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	n := copy(buf, co.buf[co.head:])
+	if n < co.size {
+		copy(buf[n:], co.buf[:co.size-n])
+	}
+	co.buf, co.head = buf, 0
+}
@@ -0,0 +1,74 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeque(t *testing.T) {
+	expected := []int{1, 2, 3}
+	actual := NewDeque(expected)
+	assert.Equal(t, expected, actual.All())
+	assert.Equal(t, 3, actual.Len())
+	assert.Equal(t, true, NewDeque[int](nil).Empty())
+}
+
+func TestDeque_PushAndPop(t *testing.T) {
+	data := NewDeque[int](nil)
+	for i := 0; i < 20; i++ {
+		data.PushBack(i).PushFront(-i - 1)
+	}
+	assert.Equal(t, 40, data.Len())
+	front, _ := data.Front()
+	back, _ := data.Back()
+	assert.Equal(t, -20, front)
+	assert.Equal(t, 19, back)
+
+	for i := 19; i >= 0; i-- {
+		v, ok := data.PopBack()
+		assert.Equal(t, true, ok)
+		assert.Equal(t, i, v)
+		v, ok = data.PopFront()
+		assert.Equal(t, true, ok)
+		assert.Equal(t, -i-1, v)
+	}
+	_, ok := data.PopFront()
+	assert.Equal(t, false, ok)
+	_, ok = data.PopBack()
+	assert.Equal(t, false, ok)
+}
+
+func TestDeque_Get(t *testing.T) {
+	data := NewDeque([]int{2, 3}).PushFront(1).PushBack(4)
+	v, ok := data.Get(0)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, v)
+	v, _ = data.Get(3)
+	assert.Equal(t, 4, v)
+	_, ok = data.Get(4)
+	assert.Equal(t, false, ok)
+	_, ok = data.Get(-1)
+	assert.Equal(t, false, ok)
+}
+
+func TestDeque_Each(t *testing.T) {
+	var actual []int
+	NewDeque([]int{2, 3, 4}).PushFront(1).Each(func(v int, i int) bool {
+		actual = append(actual, v*10+i)
+		return v < 3
+	})
+	assert.Equal(t, []int{10, 21, 32}, actual)
+}
+
+func TestDeque_Clear(t *testing.T) {
+	data := NewDeque([]int{1, 2}).Clear()
+	assert.Equal(t, true, data.Empty())
+	assert.Equal(t, []int{5}, data.PushBack(5).All())
+}
+
+func TestDeque_ToSliceCollection(t *testing.T) {
+	expected := []int{0, 1, 2}
+	actual := NewDeque([]int{1, 2}).PushFront(0).ToSliceCollection()
+	assert.Equal(t, expected, actual.All())
+}
@@ -0,0 +1,281 @@
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type EditOp int
+
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is a single step of an edit script. OldIndex points into the source
+// and is -1 for inserts, NewIndex points into the target and is -1 for deletes.
+type Edit[T any] struct {
+	Op       EditOp
+	OldIndex int
+	NewIndex int
+	Value    T
+}
+
+// EditScript computes the shortest edit script turning the collection into target
+// using Myers' O(ND) algorithm. Items are compared with cmp.Equal.
+func (co *SliceCollection[T]) EditScript(target []T) []Edit[T] {
+	return myers(co.items, target, equalFunc[T]())
+}
+
+// equalFunc returns cmp.Equal, or == for basic kinds without an Equal method where both agree,
+// because cmp.Equal allocates on every call and dominates the diff's inner loop.
+func equalFunc[T any]() func(T, T) bool {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	_, hasEqual := typ.MethodByName("Equal")
+	if !hasEqual && (typ.Kind() >= reflect.Bool && typ.Kind() <= reflect.Complex128 || typ.Kind() == reflect.String) {
+		return func(a, b T) bool { return any(a) == any(b) }
+	}
+	return func(a, b T) bool { return cmp.Equal(a, b) }
+}
+
+func (co *SliceCollection[T]) LCS(target []T) *SliceCollection[T] {
+	var ret []T
+	for _, e := range co.EditScript(target) {
+		if e.Op == EditKeep {
+			ret = append(ret, e.Value)
+		}
+	}
+	return NewSliceCollection(ret)
+}
+
+// Patch applies an edit script to the collection and returns the result as a new collection.
+// Keep and delete steps must walk the collection in order, otherwise an error is returned.
+func (co *SliceCollection[T]) Patch(script []Edit[T]) (*SliceCollection[T], error) {
+	var ret []T
+	cursor := 0
+	for i, e := range script {
+		switch e.Op {
+		case EditKeep, EditDelete:
+			if e.OldIndex != cursor || cursor >= co.Len() {
+				return nil, fmt.Errorf("slices: edit %d: %s at index %d, expected index %d", i, e.Op, e.OldIndex, cursor)
+			}
+			if !cmp.Equal(co.items[cursor], e.Value) {
+				return nil, fmt.Errorf("slices: edit %d: %s at index %d does not match the source", i, e.Op, e.OldIndex)
+			}
+			if e.Op == EditKeep {
+				ret = append(ret, e.Value)
+			}
+			cursor++
+		case EditInsert:
+			ret = append(ret, e.Value)
+		default:
+			return nil, fmt.Errorf("slices: edit %d: unknown op %s", i, e.Op)
+		}
+	}
+	if cursor != co.Len() {
+		return nil, fmt.Errorf("slices: edit script stops at index %d of %d", cursor, co.Len())
+	}
+	return NewSliceCollection(ret), nil
+}
+
+// UnifiedDiff renders an edit script in the unified diff format, with context
+// unchanged lines around each hunk.
+func UnifiedDiff[T any](script []Edit[T], fn func(T) string, context int) string {
+	if context < 0 {
+		context = 0
+	}
+
+	oldPos := make([]int, len(script)+1)
+	newPos := make([]int, len(script)+1)
+	var changes []int
+	for i, e := range script {
+		oldPos[i+1], newPos[i+1] = oldPos[i], newPos[i]
+		if e.Op != EditInsert {
+			oldPos[i+1]++
+		}
+		if e.Op != EditDelete {
+			newPos[i+1]++
+		}
+		if e.Op != EditKeep {
+			changes = append(changes, i)
+		}
+	}
+
+	var sb strings.Builder
+	for h := 0; h < len(changes); {
+		last := h
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*context+1 {
+			last++
+		}
+		start := changes[h] - context
+		if start < 0 {
+			start = 0
+		}
+		end := changes[last] + context + 1
+		if end > len(script) {
+			end = len(script)
+		}
+
+		oldCount, newCount := oldPos[end]-oldPos[start], newPos[end]-newPos[start]
+		oldStart, newStart := oldPos[start], newPos[start]
+		if oldCount > 0 {
+			oldStart++
+		}
+		if newCount > 0 {
+			newStart++
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+		for _, e := range script[start:end] {
+			prefix := " "
+			switch e.Op {
+			case EditDelete:
+				prefix = "-"
+			case EditInsert:
+				prefix = "+"
+			}
+			sb.WriteString(prefix + fn(e.Value) + "\n")
+		}
+		h = last + 1
+	}
+	return sb.String()
+}
+
+// myers computes a shortest edit script with the linear space variant of Myers' algorithm:
+// it finds the middle of an optimal path by searching forward and backward at the same
+// time, then recurses on both halves.
+func myers[T any](a, b []T, eq func(T, T) bool) []Edit[T] {
+	d := &differ[T]{a: a, b: b, eq: eq}
+	d.diff(0, len(a), 0, len(b))
+	return d.edits
+}
+
+type differ[T any] struct {
+	a, b  []T
+	eq    func(T, T) bool
+	edits []Edit[T]
+}
+
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aLo], d.b[bLo]) {
+		d.keep(aLo, bLo)
+		aLo, bLo = aLo+1, bLo+1
+	}
+	aEnd, bEnd := aHi, bHi
+	for aEnd > aLo && bEnd > bLo && d.eq(d.a[aEnd-1], d.b[bEnd-1]) {
+		aEnd, bEnd = aEnd-1, bEnd-1
+	}
+
+	x, y, ok := d.bisect(aLo, aEnd, bLo, bEnd)
+	if ok && (x > aLo || y > bLo) && (x < aEnd || y < bEnd) {
+		d.diff(aLo, x, bLo, y)
+		d.diff(x, aEnd, y, bEnd)
+	} else {
+		for i := aLo; i < aEnd; i++ {
+			d.edits = append(d.edits, Edit[T]{Op: EditDelete, OldIndex: i, NewIndex: -1, Value: d.a[i]})
+		}
+		for j := bLo; j < bEnd; j++ {
+			d.edits = append(d.edits, Edit[T]{Op: EditInsert, OldIndex: -1, NewIndex: j, Value: d.b[j]})
+		}
+	}
+
+	for aEnd < aHi {
+		d.keep(aEnd, bEnd)
+		aEnd, bEnd = aEnd+1, bEnd+1
+	}
+}
+
+func (d *differ[T]) keep(i, j int) {
+	d.edits = append(d.edits, Edit[T]{Op: EditKeep, OldIndex: i, NewIndex: j, Value: d.a[i]})
+}
+
+// bisect returns a point on a shortest path through a[aLo:aHi] and b[bLo:bHi] where the
+// forward and backward searches meet, false means the ranges have nothing in common.
+func (d *differ[T]) bisect(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	n, m := aHi-aLo, bHi-bLo
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// with an odd delta the forward search reaches the overlap first.
+	front := delta%2 != 0
+	// kStart and kEnd skip diagonals that already ran off the grid.
+	var kStart1, kEnd1, kStart2, kEnd2 int
+	for step := 0; step < maxD; step++ {
+		for k := -step + kStart1; k <= step-kEnd1; k += 2 {
+			var x int
+			if k == -step || (k != step && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.eq(d.a[aLo+x], d.b[bLo+y]) {
+				x, y = x+1, y+1
+			}
+			forward[offset+k] = x
+			switch {
+			case x > n:
+				kEnd1 += 2
+			case y > m:
+				kStart1 += 2
+			case front:
+				if i := offset + delta - k; i >= 0 && i < len(backward) && backward[i] != -1 && x >= n-backward[i] {
+					return aLo + x, bLo + y, true
+				}
+			}
+		}
+
+		for k := -step + kStart2; k <= step-kEnd2; k += 2 {
+			var x int
+			if k == -step || (k != step && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.eq(d.a[aHi-x-1], d.b[bHi-y-1]) {
+				x, y = x+1, y+1
+			}
+			backward[offset+k] = x
+			switch {
+			case x > n:
+				kEnd2 += 2
+			case y > m:
+				kStart2 += 2
+			case !front:
+				if i := offset + delta - k; i >= 0 && i < len(forward) && forward[i] != -1 {
+					fx := forward[i]
+					if fx >= n-x {
+						return aLo + fx, bLo + fx - (i - offset), true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,133 @@
+package slices
+
+import (
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceCollection_EditScript(t *testing.T) {
+	expected := []Edit[string]{
+		{Op: EditDelete, OldIndex: 0, NewIndex: -1, Value: "a"},
+		{Op: EditKeep, OldIndex: 1, NewIndex: 0, Value: "b"},
+		{Op: EditInsert, OldIndex: -1, NewIndex: 1, Value: "x"},
+		{Op: EditKeep, OldIndex: 2, NewIndex: 2, Value: "c"},
+	}
+	actual := NewSliceCollection([]string{"a", "b", "c"}).EditScript([]string{"b", "x", "c"})
+	assert.Equal(t, expected, actual)
+
+	assert.Equal(t, 0, len(NewSliceCollection([]int{}).EditScript(nil)))
+
+	actual1 := NewSliceCollection([]int{}).EditScript([]int{1, 2})
+	assert.Equal(t, []Edit[int]{
+		{Op: EditInsert, OldIndex: -1, NewIndex: 0, Value: 1},
+		{Op: EditInsert, OldIndex: -1, NewIndex: 1, Value: 2},
+	}, actual1)
+}
+
+func TestSliceCollection_EditScriptIsShortest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a, b := make([]int, r.Intn(30)), make([]int, r.Intn(30))
+		for j := range a {
+			a[j] = r.Intn(4)
+		}
+		for j := range b {
+			b[j] = r.Intn(4)
+		}
+		source := NewSliceCollection(a)
+		script := source.EditScript(b)
+		patched, err := source.Patch(script)
+		assert.Nil(t, err)
+		assert.Equal(t, b, append([]int{}, patched.All()...))
+
+		// a shortest script keeps exactly a longest common subsequence.
+		lcs := make([][]int, len(a)+1)
+		for x := range lcs {
+			lcs[x] = make([]int, len(b)+1)
+		}
+		for x := len(a) - 1; x >= 0; x-- {
+			for y := len(b) - 1; y >= 0; y-- {
+				if a[x] == b[y] {
+					lcs[x][y] = lcs[x+1][y+1] + 1
+				} else if lcs[x+1][y] > lcs[x][y+1] {
+					lcs[x][y] = lcs[x+1][y]
+				} else {
+					lcs[x][y] = lcs[x][y+1]
+				}
+			}
+		}
+		assert.Equal(t, len(a)+len(b)-lcs[0][0], len(script))
+	}
+}
+
+func TestSliceCollection_EditScriptLarge(t *testing.T) {
+	a, b := make([]int, 3000), make([]int, 3000)
+	for i := range a {
+		a[i], b[i] = i, i+3000
+	}
+	for i := 0; i < len(b); i += 3 {
+		b[i] = i
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	source := NewSliceCollection(a)
+	script := source.EditScript(b)
+	runtime.ReadMemStats(&after)
+
+	patched, err := source.Patch(script)
+	assert.Nil(t, err)
+	assert.Equal(t, b, patched.All())
+	assert.Equal(t, 1000, source.LCS(b).Len())
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20))
+
+	disjoint := make([]int, 3000)
+	for i := range disjoint {
+		disjoint[i] = i + 10000
+	}
+	assert.Equal(t, 6000, len(source.EditScript(disjoint)))
+}
+
+func TestSliceCollection_LCS(t *testing.T) {
+	expected := 4
+	actual := NewSliceCollection(strings.Split("abcabba", "")).LCS(strings.Split("cbabac", "")).Len()
+	assert.Equal(t, expected, actual)
+
+	assert.Equal(t, []int{1, 3}, NewSliceCollection([]int{1, 2, 3}).LCS([]int{1, 3, 4}).All())
+}
+
+func TestSliceCollection_Patch(t *testing.T) {
+	source := NewSliceCollection(strings.Split("abcabba", ""))
+	target := strings.Split("cbabac", "")
+	actual, err := source.Patch(source.EditScript(target))
+	assert.Nil(t, err)
+	assert.Equal(t, target, actual.All())
+
+	_, err = NewSliceCollection([]int{1, 2}).Patch([]Edit[int]{{Op: EditKeep, OldIndex: 1, NewIndex: 0, Value: 2}})
+	assert.NotNil(t, err)
+
+	_, err = NewSliceCollection([]int{1, 2}).Patch([]Edit[int]{{Op: EditDelete, OldIndex: 0, NewIndex: -1, Value: 5}})
+	assert.NotNil(t, err)
+
+	_, err = NewSliceCollection([]int{1, 2}).Patch([]Edit[int]{{Op: EditKeep, OldIndex: 0, NewIndex: 0, Value: 1}})
+	assert.NotNil(t, err)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	source := NewSliceCollection([]string{"a", "b", "c", "d", "e", "f", "g", "h"})
+	script := source.EditScript([]string{"a", "B", "c", "d", "e", "f", "g", "h", "i"})
+
+	expected := "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n@@ -8,1 +8,2 @@\n h\n+i\n"
+	actual := UnifiedDiff(script, func(s string) string { return s }, 1)
+	assert.Equal(t, expected, actual)
+
+	expected = "@@ -1,8 +1,9 @@\n a\n-b\n+B\n c\n d\n e\n f\n g\n h\n+i\n"
+	actual = UnifiedDiff(script, func(s string) string { return s }, 3)
+	assert.Equal(t, expected, actual)
+
+	assert.Equal(t, "", UnifiedDiff(source.EditScript(source.All()), func(s string) string { return s }, 3))
+}
@@ -0,0 +1,144 @@
+package slices
+
+import (
+	"container/heap"
+
+	go_collection "github.com/wwaayyaa/go-collection"
+)
+
+// Handle refers to an item pushed into a PriorityQueue, it stays valid until the item is popped or removed.
+type Handle[T any] struct {
+	value T
+	index int
+}
+
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// PriorityQueue pops the item that sorts first by less, i.e. the smallest one.
+type PriorityQueue[T any] struct {
+	h pqHeap[T]
+}
+
+func NewPriorityQueue[T any](v []T, less func(a, b T) bool) *PriorityQueue[T] {
+	co := &PriorityQueue[T]{h: pqHeap[T]{less: less, items: make([]*Handle[T], len(v))}}
+	for i, item := range v {
+		co.h.items[i] = &Handle[T]{value: item, index: i}
+	}
+	heap.Init(&co.h)
+	return co
+}
+
+func NewMinPriorityQueue[T go_collection.Ordered](v []T) *PriorityQueue[T] {
+	return NewPriorityQueue(v, func(a, b T) bool { return a < b })
+}
+
+func NewMaxPriorityQueue[T go_collection.Ordered](v []T) *PriorityQueue[T] {
+	return NewPriorityQueue(v, func(a, b T) bool { return a > b })
+}
+
+func (co *PriorityQueue[T]) Len() int {
+	return co.h.Len()
+}
+
+func (co *PriorityQueue[T]) Empty() bool {
+	return co.Len() == 0
+}
+
+func (co *PriorityQueue[T]) Push(v T) *Handle[T] {
+	h := &Handle[T]{value: v}
+	heap.Push(&co.h, h)
+	return h
+}
+
+func (co *PriorityQueue[T]) Pop() (ret T, _ bool) {
+	if co.Empty() {
+		return ret, false
+	}
+	return heap.Pop(&co.h).(*Handle[T]).value, true
+}
+
+func (co *PriorityQueue[T]) Peek() (ret T, _ bool) {
+	if co.Empty() {
+		return ret, false
+	}
+	return co.h.items[0].value, true
+}
+
+// Update replaces the value behind h and restores the heap order.
+func (co *PriorityQueue[T]) Update(h *Handle[T], v T) bool {
+	if !co.owns(h) {
+		return false
+	}
+	h.value = v
+	heap.Fix(&co.h, h.index)
+	return true
+}
+
+// Fix restores the heap order after the value behind h was changed in place.
+func (co *PriorityQueue[T]) Fix(h *Handle[T]) bool {
+	if !co.owns(h) {
+		return false
+	}
+	heap.Fix(&co.h, h.index)
+	return true
+}
+
+func (co *PriorityQueue[T]) Remove(h *Handle[T]) (ret T, _ bool) {
+	if !co.owns(h) {
+		return ret, false
+	}
+	return heap.Remove(&co.h, h.index).(*Handle[T]).value, true
+}
+
+// All returns the items in heap order, which is not sorted.
+func (co *PriorityQueue[T]) All() []T {
+	ret := make([]T, co.Len())
+	for i, h := range co.h.items {
+		ret[i] = h.value
+	}
+	return ret
+}
+
+func (co *PriorityQueue[T]) ToSliceCollection() *SliceCollection[T] {
+	return &SliceCollection[T]{items: co.All()}
+}
+
+func (co *PriorityQueue[T]) owns(h *Handle[T]) bool {
+	return h != nil && h.index >= 0 && h.index < co.Len() && co.h.items[h.index] == h
+}
+
+type pqHeap[T any] struct {
+	items []*Handle[T]
+	less  func(a, b T) bool
+}
+
+func (h *pqHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *pqHeap[T]) Less(i, j int) bool {
+	return h.less(h.items[i].value, h.items[j].value)
+}
+
+func (h *pqHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *pqHeap[T]) Push(x any) {
+	item := x.(*Handle[T])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *pqHeap[T]) Pop() any {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+	item.index = -1
+	return item
+}
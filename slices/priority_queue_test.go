@@ -0,0 +1,95 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drain[T any](pq *PriorityQueue[T]) (ret []T) {
+	for !pq.Empty() {
+		v, _ := pq.Pop()
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+func TestNewPriorityQueue(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+	pq := NewPriorityQueue([]job{{"a", 2}, {"b", 5}, {"c", 1}}, func(a, b job) bool { return a.priority > b.priority })
+	pq.Push(job{"d", 3})
+	assert.Equal(t, []job{{"b", 5}, {"d", 3}, {"a", 2}, {"c", 1}}, drain(pq))
+}
+
+func TestNewMinAndMaxPriorityQueue(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, drain(NewMinPriorityQueue([]int{3, 1, 4, 2})))
+	assert.Equal(t, []string{"c", "b", "a"}, drain(NewMaxPriorityQueue([]string{"b", "c", "a"})))
+}
+
+func TestPriorityQueue_PopAndPeek(t *testing.T) {
+	pq := NewMinPriorityQueue([]int{})
+	_, ok := pq.Peek()
+	assert.Equal(t, false, ok)
+	_, ok = pq.Pop()
+	assert.Equal(t, false, ok)
+
+	pq.Push(2)
+	pq.Push(1)
+	v, _ := pq.Peek()
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, pq.Len())
+}
+
+func TestPriorityQueue_Update(t *testing.T) {
+	pq := NewMinPriorityQueue([]int{5, 6})
+	h := pq.Push(10)
+	assert.Equal(t, 10, h.Value())
+	assert.Equal(t, true, pq.Update(h, 1))
+	v, _ := pq.Peek()
+	assert.Equal(t, 1, v)
+
+	pq.Pop()
+	assert.Equal(t, false, pq.Update(h, 0))
+	assert.Equal(t, []int{5, 6}, drain(pq))
+}
+
+func TestPriorityQueue_Fix(t *testing.T) {
+	type task struct{ cost int }
+	pq := NewPriorityQueue([]*task{{cost: 3}, {cost: 4}}, func(a, b *task) bool { return a.cost < b.cost })
+	h := pq.Push(&task{cost: 5})
+	h.Value().cost = 0
+	assert.Equal(t, true, pq.Fix(h))
+	v, _ := pq.Pop()
+	assert.Equal(t, 0, v.cost)
+}
+
+func TestPriorityQueue_Remove(t *testing.T) {
+	pq := NewMinPriorityQueue([]int{1, 5})
+	h := pq.Push(3)
+	v, ok := pq.Remove(h)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 3, v)
+	_, ok = pq.Remove(h)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, false, pq.Fix(h))
+	assert.ElementsMatch(t, []int{1, 5}, pq.All())
+	assert.ElementsMatch(t, []int{1, 5}, pq.ToSliceCollection().All())
+}
+
+func TestSliceCollection_TopK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	data := NewSliceCollection([]int{5, 1, 9, 3, 7, 9})
+	assert.Equal(t, []int{9, 9, 7}, data.TopK(3, less).All())
+	assert.Equal(t, []int{9, 9, 7, 5, 3, 1}, data.TopK(10, less).All())
+	assert.Equal(t, 0, data.TopK(0, less).Len())
+	assert.Equal(t, []int{5, 1, 9, 3, 7, 9}, data.All())
+}
+
+func TestSliceCollection_BottomK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	data := NewSliceCollection([]int{5, 1, 9, 3, 7})
+	assert.Equal(t, []int{1, 3}, data.BottomK(2, less).All())
+}
@@ -0,0 +1,111 @@
+package slices
+
+import (
+	"errors"
+)
+
+type OverflowPolicy int
+
+const (
+	// RingOverwrite drops the oldest item to make room for a new one.
+	RingOverwrite OverflowPolicy = iota
+	// RingReject refuses new items while the buffer is full.
+	RingReject
+)
+
+var ErrRingBufferFull = errors.New("slices: ring buffer is full")
+
+// RingBuffer is a fixed-capacity FIFO buffer.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	policy OverflowPolicy
+}
+
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("slices: ring buffer capacity must be positive")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), policy: policy}
+}
+
+func (co *RingBuffer[T]) Len() int {
+	return co.size
+}
+
+func (co *RingBuffer[T]) Cap() int {
+	return len(co.buf)
+}
+
+func (co *RingBuffer[T]) Empty() bool {
+	return co.size == 0
+}
+
+func (co *RingBuffer[T]) Full() bool {
+	return co.size == len(co.buf)
+}
+
+// Get returns the i-th item, counting from the oldest one.
+func (co *RingBuffer[T]) Get(i int) (ret T, _ bool) {
+	if i < 0 || i >= co.size {
+		return ret, false
+	}
+	return co.buf[co.index(i)], true
+}
+
+func (co *RingBuffer[T]) Peek() (T, bool) {
+	return co.Get(0)
+}
+
+// Push appends v. When the buffer is full it either overwrites the oldest item
+// or returns ErrRingBufferFull, depending on the policy.
+func (co *RingBuffer[T]) Push(v T) error {
+	if co.Full() {
+		if co.policy == RingReject {
+			return ErrRingBufferFull
+		}
+		co.buf[co.head] = v
+		co.head = co.index(1)
+		return nil
+	}
+	co.buf[co.index(co.size)] = v
+	co.size++
+	return nil
+}
+
+func (co *RingBuffer[T]) Pop() (ret T, _ bool) {
+	if co.size == 0 {
+		return ret, false
+	}
+	var zero T
+	ret, co.buf[co.head] = co.buf[co.head], zero
+	co.head = co.index(1)
+	co.size--
+	return ret, true
+}
+
+func (co *RingBuffer[T]) Each(fn func(T, int) bool) *RingBuffer[T] {
+	for i := 0; i < co.size; i++ {
+		if !fn(co.buf[co.index(i)], i) {
+			break
+		}
+	}
+	return co
+}
+
+func (co *RingBuffer[T]) All() []T {
+	ret := make([]T, co.size)
+	for i := range ret {
+		ret[i] = co.buf[co.index(i)]
+	}
+	return ret
+}
+
+func (co *RingBuffer[T]) ToSliceCollection() *SliceCollection[T] {
+	return &SliceCollection[T]{items: co.All()}
+}
+
+func (co *RingBuffer[T]) index(i int) int {
+	return (co.head + i) % len(co.buf)
+}
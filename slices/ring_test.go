@@ -0,0 +1,72 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRingBuffer(t *testing.T) {
+	data := NewRingBuffer[int](3, RingOverwrite)
+	assert.Equal(t, 3, data.Cap())
+	assert.Equal(t, true, data.Empty())
+	assert.Panics(t, func() { NewRingBuffer[int](0, RingReject) })
+}
+
+func TestRingBuffer_PushOverwrite(t *testing.T) {
+	data := NewRingBuffer[int](3, RingOverwrite)
+	for i := 1; i <= 5; i++ {
+		assert.Nil(t, data.Push(i))
+	}
+	assert.Equal(t, true, data.Full())
+	assert.Equal(t, []int{3, 4, 5}, data.All())
+}
+
+func TestRingBuffer_PushReject(t *testing.T) {
+	data := NewRingBuffer[int](2, RingReject)
+	assert.Nil(t, data.Push(1))
+	assert.Nil(t, data.Push(2))
+	assert.Equal(t, ErrRingBufferFull, data.Push(3))
+	assert.Equal(t, []int{1, 2}, data.All())
+}
+
+func TestRingBuffer_Pop(t *testing.T) {
+	data := NewRingBuffer[int](2, RingOverwrite)
+	_ = data.Push(1)
+	_ = data.Push(2)
+	_ = data.Push(3)
+	v, ok := data.Pop()
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, v)
+	_ = data.Push(4)
+	assert.Equal(t, []int{3, 4}, data.All())
+
+	data.Pop()
+	data.Pop()
+	_, ok = data.Pop()
+	assert.Equal(t, false, ok)
+}
+
+func TestRingBuffer_GetAndPeek(t *testing.T) {
+	data := NewRingBuffer[string](2, RingOverwrite)
+	_ = data.Push("a")
+	_ = data.Push("b")
+	_ = data.Push("c")
+	v, _ := data.Peek()
+	assert.Equal(t, "b", v)
+	v, _ = data.Get(1)
+	assert.Equal(t, "c", v)
+	_, ok := data.Get(2)
+	assert.Equal(t, false, ok)
+}
+
+func TestRingBuffer_EachAndToSliceCollection(t *testing.T) {
+	data := NewRingBuffer[int](3, RingOverwrite)
+	for i := 1; i <= 4; i++ {
+		_ = data.Push(i)
+	}
+	sum := 0
+	data.Each(func(v int, _ int) bool { sum += v; return true })
+	assert.Equal(t, 9, sum)
+	assert.Equal(t, []int{2, 3, 4}, data.ToSliceCollection().All())
+}
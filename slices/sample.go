@@ -0,0 +1,145 @@
+package slices
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// randomizer is the part of *rand.Rand used for sampling, so the global source can be used as well.
+type randomizer interface {
+	Intn(n int) int
+	Float64() float64
+	Shuffle(n int, swap func(i, j int))
+}
+
+type globalRand struct{}
+
+func (globalRand) Intn(n int) int                     { return rand.Intn(n) }
+func (globalRand) Float64() float64                   { return rand.Float64() }
+func (globalRand) Shuffle(n int, swap func(i, j int)) { rand.Shuffle(n, swap) }
+
+func randFrom(source []rand.Source) randomizer {
+	if len(source) > 0 && source[0] != nil {
+		return rand.New(source[0])
+	}
+	return globalRand{}
+}
+
+// Sample returns n items picked uniformly at random without replacement, in random order.
+// If n exceeds the length, all items are returned shuffled.
+func (co *SliceCollection[T]) Sample(n int, source ...rand.Source) *SliceCollection[T] {
+	n = co.clamp(n)
+	r := randFrom(source)
+	items := append([]T(nil), co.items...)
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(len(items)-i)
+		items[i], items[j] = items[j], items[i]
+	}
+	return NewSliceCollection(items[:n])
+}
+
+// WeightedChoice picks one item with probability proportional to its weight.
+// Negative weights count as zero, false is returned if no item has a positive weight.
+func (co *SliceCollection[T]) WeightedChoice(weight func(T, int) float64, source ...rand.Source) (T, bool) {
+	weights := make([]float64, co.Len())
+	total := 0.0
+	for i, v := range co.items {
+		weights[i] = math.Max(weight(v, i), 0)
+		total += weights[i]
+	}
+	if total <= 0 {
+		var zero T
+		return zero, false
+	}
+
+	target := randFrom(source).Float64() * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if target < w {
+			return co.items[i], true
+		}
+		target -= w
+		last = i
+	}
+	// floating point rounding can leave a tiny remainder, it belongs to the last candidate.
+	return co.items[last], true
+}
+
+// WeightedSample picks up to n items without replacement, each draw favoring items with a larger weight.
+// Items with a non-positive weight are never picked.
+func (co *SliceCollection[T]) WeightedSample(n int, weight func(T, int) float64, source ...rand.Source) *SliceCollection[T] {
+	// Efraimidis-Spirakis: the n items with the largest u^(1/w) form the sample.
+	r := randFrom(source)
+	var keyed []weightedItem[T]
+	for i, v := range co.items {
+		if w := weight(v, i); w > 0 {
+			keyed = append(keyed, weightedItem[T]{key: math.Pow(r.Float64(), 1/w), value: v})
+		}
+	}
+	sort.Slice(keyed, func(i, j int) bool { return keyed[i].key > keyed[j].key })
+
+	if n < 0 {
+		n = 0
+	}
+	if n > len(keyed) {
+		n = len(keyed)
+	}
+	ret := make([]T, n)
+	for i := range ret {
+		ret[i] = keyed[i].value
+	}
+	return NewSliceCollection(ret)
+}
+
+// Reservoir keeps a uniform random sample of fixed size over a stream of unknown length.
+type Reservoir[T any] struct {
+	items []T
+	size  int
+	seen  int
+	rand  randomizer
+}
+
+func NewReservoir[T any](size int, source ...rand.Source) *Reservoir[T] {
+	if size <= 0 {
+		panic("slices: reservoir size must be positive")
+	}
+	return &Reservoir[T]{items: make([]T, 0, size), size: size, rand: randFrom(source)}
+}
+
+func (co *Reservoir[T]) Add(v T) *Reservoir[T] {
+	co.seen++
+	if len(co.items) < co.size {
+		co.items = append(co.items, v)
+		return co
+	}
+	if j := co.rand.Intn(co.seen); j < co.size {
+		co.items[j] = v
+	}
+	return co
+}
+
+// Seen returns how many items were added to the reservoir.
+func (co *Reservoir[T]) Seen() int {
+	return co.seen
+}
+
+func (co *Reservoir[T]) Len() int {
+	return len(co.items)
+}
+
+func (co *Reservoir[T]) All() []T {
+	return append([]T(nil), co.items...)
+}
+
+func (co *Reservoir[T]) ToSliceCollection() *SliceCollection[T] {
+	return NewSliceCollection(co.All())
+}
+
+type weightedItem[T any] struct {
+	key   float64
+	value T
+}
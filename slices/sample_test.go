@@ -0,0 +1,104 @@
+package slices
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceCollection_ShuffleWithSource(t *testing.T) {
+	a := NewSliceCollection([]int{1, 2, 3, 4, 5, 6, 7}).Shuffle(rand.NewSource(1)).All()
+	b := NewSliceCollection([]int{1, 2, 3, 4, 5, 6, 7}).Shuffle(rand.NewSource(1)).All()
+	assert.Equal(t, a, b)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7}, a)
+}
+
+func TestSliceCollection_Sample(t *testing.T) {
+	data := NewSliceCollection([]int{1, 2, 3, 4, 5, 6, 7})
+	actual := data.Sample(3, rand.NewSource(1))
+	assert.Equal(t, 3, actual.Len())
+	assert.Equal(t, actual.All(), data.Sample(3, rand.NewSource(1)).All())
+	assert.Equal(t, actual.Len(), actual.Uniq().Len())
+	assert.Subset(t, data.All(), actual.All())
+
+	assert.ElementsMatch(t, data.All(), data.Sample(10).All())
+	assert.Equal(t, 0, data.Sample(-1).Len())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, data.All())
+}
+
+func TestSliceCollection_WeightedChoice(t *testing.T) {
+	data := NewSliceCollection([]string{"never", "rare", "often"})
+	weights := map[string]float64{"never": 0, "rare": 1, "often": 9}
+	weight := func(v string, _ int) float64 { return weights[v] }
+
+	counts := map[string]int{}
+	source := rand.NewSource(1)
+	for i := 0; i < 10000; i++ {
+		v, ok := data.WeightedChoice(weight, source)
+		assert.True(t, ok)
+		counts[v]++
+	}
+	assert.Equal(t, 0, counts["never"])
+	assert.InDelta(t, 9000, counts["often"], 300)
+
+	_, ok := data.WeightedChoice(func(string, int) float64 { return -1 })
+	assert.False(t, ok)
+}
+
+func TestSliceCollection_WeightedSample(t *testing.T) {
+	data := NewSliceCollection([]string{"a", "b", "c", "d"})
+	weight := func(v string, _ int) float64 {
+		if v == "d" {
+			return 0
+		}
+		return 1
+	}
+	actual := data.WeightedSample(5, weight, rand.NewSource(1))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, actual.All())
+	assert.Equal(t, actual.All(), data.WeightedSample(5, weight, rand.NewSource(1)).All())
+
+	heavy := 0
+	source := rand.NewSource(1)
+	for i := 0; i < 1000; i++ {
+		if data.WeightedSample(1, func(v string, _ int) float64 {
+			if v == "a" {
+				return 100
+			}
+			return 1
+		}, source).All()[0] == "a" {
+			heavy++
+		}
+	}
+	assert.Greater(t, heavy, 900)
+}
+
+func TestReservoir(t *testing.T) {
+	r := NewReservoir[int](3, rand.NewSource(1))
+	for i := 0; i < 2; i++ {
+		r.Add(i)
+	}
+	assert.Equal(t, []int{0, 1}, r.All())
+
+	for i := 2; i < 100; i++ {
+		r.Add(i)
+	}
+	assert.Equal(t, 100, r.Seen())
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, 3, r.ToSliceCollection().Uniq().Len())
+	assert.Panics(t, func() { NewReservoir[int](0) })
+
+	// every item should end up in a size-1 reservoir over 4 items about a quarter of the time.
+	counts := make([]int, 4)
+	source := rand.NewSource(2)
+	for i := 0; i < 4000; i++ {
+		r := NewReservoir[int](1, source)
+		for j := 0; j < 4; j++ {
+			r.Add(j)
+		}
+		counts[r.All()[0]]++
+	}
+	for _, c := range counts {
+		assert.InDelta(t, 1000, c, 150)
+	}
+}
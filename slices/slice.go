@@ -221,6 +221,8 @@ func (co *SliceCollection[T]) Shift() (T, bool) {
 	if !ok {
 		return v, false
 	}
+	var zero T
+	co.items[0] = zero
 	co.items = co.items[1:]
 	return v, true
 }
@@ -261,8 +263,9 @@ func (co *SliceCollection[T]) Uniq() *SliceCollection[T] {
 	return ret
 }
 
-func (co *SliceCollection[T]) Shuffle() *SliceCollection[T] {
-	rand.Shuffle(co.Len(), func(i, j int) {
+// Shuffle shuffles the collection in place, using source instead of the global math/rand source if given.
+func (co *SliceCollection[T]) Shuffle(source ...rand.Source) *SliceCollection[T] {
+	randFrom(source).Shuffle(co.Len(), func(i, j int) {
 		co.items[i], co.items[j] = co.items[j], co.items[i]
 	})
 
@@ -305,6 +308,107 @@ func (co *SliceCollection[T]) Except(keys []int) *SliceCollection[T] {
 	return NewSliceCollection(ret)
 }
 
+func (co *SliceCollection[T]) Partition(fn func(T, int) bool) (*SliceCollection[T], *SliceCollection[T]) {
+	var matched, rest []T
+	for i, v := range co.items {
+		if fn(v, i) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return NewSliceCollection(matched), NewSliceCollection(rest)
+}
+
+// PartitionN distributes the items into k buckets by the index fn returns,
+// items whose index falls outside [0, k) are dropped.
+func (co *SliceCollection[T]) PartitionN(k int, fn func(T, int) int) []*SliceCollection[T] {
+	if k < 0 {
+		k = 0
+	}
+	buckets := make([][]T, k)
+	for i, v := range co.items {
+		if b := fn(v, i); b >= 0 && b < k {
+			buckets[b] = append(buckets[b], v)
+		}
+	}
+	ret := make([]*SliceCollection[T], k)
+	for i, bucket := range buckets {
+		ret[i] = NewSliceCollection(bucket)
+	}
+	return ret
+}
+
+func (co *SliceCollection[T]) Span(fn func(T, int) bool) (*SliceCollection[T], *SliceCollection[T]) {
+	i := co.prefixLen(fn)
+	return NewSliceCollection(co.items[:i]), NewSliceCollection(co.items[i:])
+}
+
+func (co *SliceCollection[T]) Break(fn func(T, int) bool) (*SliceCollection[T], *SliceCollection[T]) {
+	return co.Span(func(v T, i int) bool { return !fn(v, i) })
+}
+
+func (co *SliceCollection[T]) TakeWhile(fn func(T, int) bool) *SliceCollection[T] {
+	return NewSliceCollection(co.items[:co.prefixLen(fn)])
+}
+
+func (co *SliceCollection[T]) DropWhile(fn func(T, int) bool) *SliceCollection[T] {
+	return NewSliceCollection(co.items[co.prefixLen(fn):])
+}
+
+func (co *SliceCollection[T]) Take(n int) *SliceCollection[T] {
+	return NewSliceCollection(co.items[:co.clamp(n)])
+}
+
+func (co *SliceCollection[T]) Drop(n int) *SliceCollection[T] {
+	return NewSliceCollection(co.items[co.clamp(n):])
+}
+
+// TopK returns the k largest items by less, largest first.
+func (co *SliceCollection[T]) TopK(k int, less func(a, b T) bool) *SliceCollection[T] {
+	return co.BottomK(k, func(a, b T) bool { return less(b, a) })
+}
+
+// BottomK returns the k smallest items by less, smallest first.
+func (co *SliceCollection[T]) BottomK(k int, less func(a, b T) bool) *SliceCollection[T] {
+	k = co.clamp(k)
+	// keep the k best items in a queue whose head is the worst of them
+	pq := NewPriorityQueue([]T{}, func(a, b T) bool { return less(b, a) })
+	for _, v := range co.items {
+		if pq.Len() < k {
+			pq.Push(v)
+		} else if worst, ok := pq.Peek(); ok && less(v, worst) {
+			pq.Pop()
+			pq.Push(v)
+		}
+	}
+
+	ret := make([]T, pq.Len())
+	for i := len(ret) - 1; i >= 0; i-- {
+		ret[i], _ = pq.Pop()
+	}
+	return NewSliceCollection(ret)
+}
+
+func (co *SliceCollection[T]) prefixLen(fn func(T, int) bool) int {
+	for i, v := range co.items {
+		if !fn(v, i) {
+			return i
+		}
+	}
+	return co.Len()
+}
+
+func (co *SliceCollection[T]) clamp(n int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > co.Len() {
+		return co.Len()
+	}
+	return n
+}
+
 // 1.18 not allow type parameters in methods
 // In order to increase flexibility, return any type, so it can only be a function independently.
 // https://github.com/golang/go/issues/49085
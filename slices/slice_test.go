@@ -378,3 +378,53 @@ func TestFlatMap(t *testing.T) {
 	})
 	assert.Equal(t, expected, actual)
 }
+
+func TestSliceCollection_Partition(t *testing.T) {
+	even, odd := NewSliceCollection([]int{1, 2, 3, 4, 5}).Partition(func(v, _ int) bool { return v%2 == 0 })
+	assert.Equal(t, []int{2, 4}, even.All())
+	assert.Equal(t, []int{1, 3, 5}, odd.All())
+
+	matched, rest := NewSliceCollection([]int{1, 2}).Partition(func(v, _ int) bool { return v > 5 })
+	assert.Equal(t, 0, matched.Len())
+	assert.Equal(t, []int{1, 2}, rest.All())
+}
+
+func TestSliceCollection_PartitionN(t *testing.T) {
+	actual := NewSliceCollection([]int{0, 1, 2, 3, 4, 5, 6}).PartitionN(3, func(v, _ int) int { return v % 3 })
+	assert.Equal(t, 3, len(actual))
+	assert.Equal(t, []int{0, 3, 6}, actual[0].All())
+	assert.Equal(t, []int{1, 4}, actual[1].All())
+	assert.Equal(t, []int{2, 5}, actual[2].All())
+
+	actual = NewSliceCollection([]int{1, 2, 3}).PartitionN(2, func(v, _ int) int { return v - 2 })
+	assert.Equal(t, []int{2}, actual[0].All())
+	assert.Equal(t, []int{3}, actual[1].All())
+}
+
+func TestSliceCollection_SpanAndBreak(t *testing.T) {
+	prefix, rest := NewSliceCollection([]int{1, 2, 3, 1, 2}).Span(func(v, _ int) bool { return v < 3 })
+	assert.Equal(t, []int{1, 2}, prefix.All())
+	assert.Equal(t, []int{3, 1, 2}, rest.All())
+
+	prefix, rest = NewSliceCollection([]int{1, 2, 3, 1, 2}).Break(func(v, _ int) bool { return v == 3 })
+	assert.Equal(t, []int{1, 2}, prefix.All())
+	assert.Equal(t, []int{3, 1, 2}, rest.All())
+}
+
+func TestSliceCollection_TakeWhileAndDropWhile(t *testing.T) {
+	data := NewSliceCollection([]int{2, 4, 5, 6})
+	assert.Equal(t, []int{2, 4}, data.TakeWhile(func(v, _ int) bool { return v%2 == 0 }).All())
+	assert.Equal(t, []int{5, 6}, data.DropWhile(func(v, _ int) bool { return v%2 == 0 }).All())
+	assert.Equal(t, []int{2, 4, 5, 6}, data.TakeWhile(func(v, _ int) bool { return v < 10 }).All())
+	assert.Equal(t, 0, data.DropWhile(func(v, _ int) bool { return v < 10 }).Len())
+}
+
+func TestSliceCollection_TakeAndDrop(t *testing.T) {
+	data := NewSliceCollection([]int{1, 2, 3, 4})
+	assert.Equal(t, []int{1, 2}, data.Take(2).All())
+	assert.Equal(t, []int{3, 4}, data.Drop(2).All())
+	assert.Equal(t, []int{1, 2, 3, 4}, data.Take(10).All())
+	assert.Equal(t, 0, data.Drop(10).Len())
+	assert.Equal(t, 0, data.Take(-1).Len())
+	assert.Equal(t, []int{1, 2, 3, 4}, data.All())
+}
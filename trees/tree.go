@@ -0,0 +1,217 @@
+// Package trees builds hierarchies from flat rows that reference their parent.
+package trees
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+var (
+	ErrDuplicateID = errors.New("trees: duplicate id")
+	ErrCycle       = errors.New("trees: parent references form a cycle")
+)
+
+type Node[T any] struct {
+	Value    T
+	Parent   *Node[T]
+	Children []*Node[T]
+}
+
+func (n *Node[T]) IsRoot() bool {
+	return n.Parent == nil
+}
+
+func (n *Node[T]) IsLeaf() bool {
+	return len(n.Children) == 0
+}
+
+// Depth returns the number of ancestors of the node, roots have depth 0.
+func (n *Node[T]) Depth() int {
+	depth := 0
+	for cur := n.Parent; cur != nil; cur = cur.Parent {
+		depth++
+	}
+	return depth
+}
+
+// PathToRoot returns the node's value followed by the values of its ancestors up to the root.
+func (n *Node[T]) PathToRoot() []T {
+	var ret []T
+	for cur := n; cur != nil; cur = cur.Parent {
+		ret = append(ret, cur.Value)
+	}
+	return ret
+}
+
+// Tree is a forest, every row without a known parent becomes a root.
+type Tree[T any] struct {
+	roots []*Node[T]
+	size  int
+}
+
+// BuildTree links every item to the item whose id equals its parent id. Items whose parent
+// id matches no item are roots, children keep the order of the collection.
+func BuildTree[T any, K comparable](co *slices.SliceCollection[T], id func(T) K, parent func(T) K) (*Tree[T], error) {
+	items := co.All()
+	nodes := make(map[K]*Node[T], len(items))
+	ordered := make([]*Node[T], len(items))
+	for i, v := range items {
+		k := id(v)
+		if _, ok := nodes[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateID, k)
+		}
+		ordered[i] = &Node[T]{Value: v}
+		nodes[k] = ordered[i]
+	}
+
+	t := &Tree[T]{}
+	for i, v := range items {
+		n := ordered[i]
+		if p, ok := nodes[parent(v)]; ok {
+			n.Parent = p
+			p.Children = append(p.Children, n)
+		} else {
+			t.roots = append(t.roots, n)
+		}
+	}
+
+	t.PreOrder(func(*Node[T], int) bool {
+		t.size++
+		return true
+	})
+	if t.size < len(items) {
+		return nil, ErrCycle
+	}
+	return t, nil
+}
+
+func (t *Tree[T]) Roots() []*Node[T] {
+	return append([]*Node[T](nil), t.roots...)
+}
+
+func (t *Tree[T]) Len() int {
+	return t.size
+}
+
+// Depth returns the number of levels of the tree, 0 for an empty tree.
+func (t *Tree[T]) Depth() int {
+	max := 0
+	t.PreOrder(func(_ *Node[T], depth int) bool {
+		if depth+1 > max {
+			max = depth + 1
+		}
+		return true
+	})
+	return max
+}
+
+// PreOrder visits every node before its children, returning false from fn stops the traversal.
+func (t *Tree[T]) PreOrder(fn func(*Node[T], int) bool) *Tree[T] {
+	var visit func(n *Node[T], depth int) bool
+	visit = func(n *Node[T], depth int) bool {
+		if !fn(n, depth) {
+			return false
+		}
+		for _, c := range n.Children {
+			if !visit(c, depth+1) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, r := range t.roots {
+		if !visit(r, 0) {
+			break
+		}
+	}
+	return t
+}
+
+// PostOrder visits every node after its children, returning false from fn stops the traversal.
+func (t *Tree[T]) PostOrder(fn func(*Node[T], int) bool) *Tree[T] {
+	var visit func(n *Node[T], depth int) bool
+	visit = func(n *Node[T], depth int) bool {
+		for _, c := range n.Children {
+			if !visit(c, depth+1) {
+				return false
+			}
+		}
+		return fn(n, depth)
+	}
+	for _, r := range t.roots {
+		if !visit(r, 0) {
+			break
+		}
+	}
+	return t
+}
+
+// LevelOrder visits the nodes level by level, returning false from fn stops the traversal.
+func (t *Tree[T]) LevelOrder(fn func(*Node[T], int) bool) *Tree[T] {
+	level := t.roots
+	for depth := 0; len(level) > 0; depth++ {
+		var next []*Node[T]
+		for _, n := range level {
+			if !fn(n, depth) {
+				return t
+			}
+			next = append(next, n.Children...)
+		}
+		level = next
+	}
+	return t
+}
+
+// Find returns the first node in pre-order whose value matches.
+func (t *Tree[T]) Find(fn func(T) bool) (ret *Node[T], _ bool) {
+	t.PreOrder(func(n *Node[T], _ int) bool {
+		if fn(n.Value) {
+			ret = n
+			return false
+		}
+		return true
+	})
+	return ret, ret != nil
+}
+
+// Filter returns a new tree with the matching nodes and their ancestors, so every match
+// keeps its path to the root.
+func (t *Tree[T]) Filter(fn func(T) bool) *Tree[T] {
+	var filter func(n *Node[T], parent *Node[T]) *Node[T]
+	filter = func(n *Node[T], parent *Node[T]) *Node[T] {
+		cp := &Node[T]{Value: n.Value, Parent: parent}
+		for _, c := range n.Children {
+			if fc := filter(c, cp); fc != nil {
+				cp.Children = append(cp.Children, fc)
+			}
+		}
+		if len(cp.Children) == 0 && !fn(n.Value) {
+			return nil
+		}
+		return cp
+	}
+
+	ret := &Tree[T]{}
+	for _, r := range t.roots {
+		if fr := filter(r, nil); fr != nil {
+			ret.roots = append(ret.roots, fr)
+		}
+	}
+	ret.PreOrder(func(*Node[T], int) bool {
+		ret.size++
+		return true
+	})
+	return ret
+}
+
+// Flatten returns the values in pre-order.
+func (t *Tree[T]) Flatten() *slices.SliceCollection[T] {
+	ret := make([]T, 0, t.size)
+	t.PreOrder(func(n *Node[T], _ int) bool {
+		ret = append(ret, n.Value)
+		return true
+	})
+	return slices.NewSliceCollection(ret)
+}
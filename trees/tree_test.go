@@ -0,0 +1,103 @@
+package trees
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wwaayyaa/go-collection/slices"
+)
+
+type category struct {
+	ID       int
+	ParentID int
+	Name     string
+}
+
+func categories() *Tree[category] {
+	t, err := BuildTree(slices.NewSliceCollection([]category{
+		{ID: 1, ParentID: 0, Name: "electronics"},
+		{ID: 2, ParentID: 1, Name: "phones"},
+		{ID: 3, ParentID: 1, Name: "laptops"},
+		{ID: 4, ParentID: 2, Name: "android"},
+		{ID: 5, ParentID: 0, Name: "books"},
+		{ID: 6, ParentID: 5, Name: "novels"},
+	}), func(c category) int { return c.ID }, func(c category) int { return c.ParentID })
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func names(nodes []category) []string {
+	ret := make([]string, len(nodes))
+	for i, n := range nodes {
+		ret[i] = n.Name
+	}
+	return ret
+}
+
+func TestBuildTree(t *testing.T) {
+	tree := categories()
+	assert.Equal(t, 6, tree.Len())
+	assert.Equal(t, 3, tree.Depth())
+	assert.Equal(t, 2, len(tree.Roots()))
+	assert.Equal(t, "phones", tree.Roots()[0].Children[0].Value.Name)
+
+	id := func(c category) int { return c.ID }
+	parent := func(c category) int { return c.ParentID }
+	_, err := BuildTree(slices.NewSliceCollection([]category{{ID: 1}, {ID: 1}}), id, parent)
+	assert.ErrorIs(t, err, ErrDuplicateID)
+	_, err = BuildTree(slices.NewSliceCollection([]category{{ID: 1, ParentID: 2}, {ID: 2, ParentID: 1}}), id, parent)
+	assert.ErrorIs(t, err, ErrCycle)
+
+	empty, err := BuildTree(slices.NewSliceCollection([]category{}), id, parent)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, empty.Depth())
+}
+
+func TestTree_Traversals(t *testing.T) {
+	tree := categories()
+	var pre, post, level []string
+	var depths []int
+	tree.PreOrder(func(n *Node[category], depth int) bool {
+		pre, depths = append(pre, n.Value.Name), append(depths, depth)
+		return true
+	})
+	tree.PostOrder(func(n *Node[category], _ int) bool {
+		post = append(post, n.Value.Name)
+		return true
+	})
+	tree.LevelOrder(func(n *Node[category], _ int) bool {
+		level = append(level, n.Value.Name)
+		return n.Value.Name != "laptops"
+	})
+	assert.Equal(t, []string{"electronics", "phones", "android", "laptops", "books", "novels"}, pre)
+	assert.Equal(t, []int{0, 1, 2, 1, 0, 1}, depths)
+	assert.Equal(t, []string{"android", "phones", "laptops", "electronics", "novels", "books"}, post)
+	assert.Equal(t, []string{"electronics", "books", "phones", "laptops"}, level)
+}
+
+func TestNode_PathToRoot(t *testing.T) {
+	node, ok := categories().Find(func(c category) bool { return c.Name == "android" })
+	assert.True(t, ok)
+	assert.Equal(t, []string{"android", "phones", "electronics"}, names(node.PathToRoot()))
+	assert.Equal(t, 2, node.Depth())
+	assert.True(t, node.IsLeaf())
+	assert.False(t, node.IsRoot())
+
+	_, ok = categories().Find(func(c category) bool { return c.Name == "toys" })
+	assert.False(t, ok)
+}
+
+func TestTree_Filter(t *testing.T) {
+	tree := categories()
+	filtered := tree.Filter(func(c category) bool { return c.Name == "android" || c.Name == "books" })
+	assert.Equal(t, []string{"electronics", "phones", "android", "books"}, names(filtered.Flatten().All()))
+	assert.Equal(t, 4, filtered.Len())
+	assert.Equal(t, 6, tree.Len())
+	assert.Equal(t, 0, tree.Filter(func(category) bool { return false }).Len())
+}
+
+func TestTree_Flatten(t *testing.T) {
+	assert.Equal(t, []string{"electronics", "phones", "android", "laptops", "books", "novels"}, names(categories().Flatten().All()))
+}